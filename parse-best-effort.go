@@ -0,0 +1,126 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse
+
+import (
+	"context"
+	"reflect"
+)
+
+// SkippedElement describes an element dropped by a best-effort array parser.
+type SkippedElement struct {
+	// Index is the position of the element in the input.
+	Index int
+	// Err is the *ParseError of the element, its Path is "[Index]".
+	Err error
+}
+
+// ParseIntArrayBestEffort converts an interface{} value to an int slice, skipping elements that
+// cannot be converted with ParseInt instead of failing.
+// Accepts any slice or array. Returns the converted elements in input order and one
+// SkippedElement per dropped element.
+// Returns a *ParseError only if the value itself is no slice or array.
+func ParseIntArrayBestEffort(
+	ctx context.Context,
+	value interface{},
+) ([]int, []SkippedElement, error) {
+	values, err := toInterfaceSlice(ctx, value, "[]int")
+	if err != nil || values == nil {
+		return nil, nil, err
+	}
+	result, skipped := parseBestEffort(ctx, values, ParseInt)
+	return result, skipped, nil
+}
+
+// ParseInt64ArrayBestEffort converts an interface{} value to an int64 slice, skipping elements
+// that cannot be converted with ParseInt64 instead of failing.
+// Accepts any slice or array. Returns the converted elements in input order and one
+// SkippedElement per dropped element.
+// Returns a *ParseError only if the value itself is no slice or array.
+func ParseInt64ArrayBestEffort(
+	ctx context.Context,
+	value interface{},
+) ([]int64, []SkippedElement, error) {
+	values, err := toInterfaceSlice(ctx, value, "[]int64")
+	if err != nil || values == nil {
+		return nil, nil, err
+	}
+	result, skipped := parseBestEffort(ctx, values, ParseInt64)
+	return result, skipped, nil
+}
+
+// ParseStringsBestEffort converts an interface{} value to a string slice, skipping elements that
+// cannot be converted with ParseString instead of failing.
+// Accepts the same single values as ParseStrings (string, HasStrings, HasString) and any slice
+// or array. Returns the converted elements in input order and one SkippedElement per dropped
+// element.
+// Returns a *ParseError only if the value itself cannot be converted.
+func ParseStringsBestEffort(
+	ctx context.Context,
+	value interface{},
+) ([]string, []SkippedElement, error) {
+	switch indirect(value).(type) {
+	case nil, string, HasStrings, HasString:
+		result, err := ParseStrings(ctx, value)
+		return result, nil, err
+	}
+	values, err := toInterfaceSlice(ctx, value, "[]string")
+	if err != nil {
+		return nil, nil, err
+	}
+	result, skipped := parseBestEffort(ctx, values, ParseString)
+	return result, skipped, nil
+}
+
+// parseBestEffort converts each value with parseFn and collects the failing elements.
+func parseBestEffort[T any](
+	ctx context.Context,
+	values []interface{},
+	parseFn func(ctx context.Context, value interface{}) (T, error),
+) ([]T, []SkippedElement) {
+	result := make([]T, 0, len(values))
+	var skipped []SkippedElement
+	for i, value := range values {
+		parsed, err := parseFn(ctx, value)
+		if err != nil {
+			skipped = append(skipped, SkippedElement{
+				Index: i,
+				Err:   withPathPrefix(err, indexPath(i)),
+			})
+			continue
+		}
+		result = append(result, parsed)
+	}
+	return result, skipped
+}
+
+// toInterfaceSlice converts any slice or array to a slice of interface{}.
+// nil returns nil or an error according to the NilPolicy of ctx.
+func toInterfaceSlice(
+	ctx context.Context,
+	value interface{},
+	targetType string,
+) ([]interface{}, error) {
+	value = indirect(value)
+	if value == nil {
+		return nil, nilParseError(ctx, targetType)
+	}
+	if values, ok := value.([]interface{}); ok {
+		return values, nil
+	}
+	split, err := expandString(ctx, value)
+	if err != nil {
+		return nil, newParseError(value, targetType, err)
+	}
+	v := reflect.ValueOf(split)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return nil, newParseError(value, targetType, errUnsupportedType(ctx, value))
+	}
+	result := make([]interface{}, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		result[i] = v.Index(i).Interface()
+	}
+	return result, nil
+}
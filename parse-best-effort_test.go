@@ -0,0 +1,105 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse_test
+
+import (
+	"context"
+	stderrors "errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/parse"
+)
+
+func skippedIndexes(skipped []parse.SkippedElement) []int {
+	var result []int
+	for _, s := range skipped {
+		var parseErr *parse.ParseError
+		Expect(stderrors.As(s.Err, &parseErr)).To(BeTrue())
+		Expect(stderrors.Is(s.Err, parse.ErrInvalidType) ||
+			stderrors.Is(s.Err, parse.ErrOutOfRange)).To(BeTrue())
+		result = append(result, s.Index)
+	}
+	return result
+}
+
+var _ = DescribeTable("ParseIntArrayBestEffort",
+	func(value interface{}, expectedResult []int, expectedSkipped []int, expectError bool) {
+		result, skipped, err := parse.ParseIntArrayBestEffort(context.Background(), value)
+		if expectError {
+			Expect(err).NotTo(BeNil())
+			return
+		}
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal(expectedResult))
+		Expect(skippedIndexes(skipped)).To(Equal(expectedSkipped))
+	},
+	Entry("all valid", []interface{}{1, "2", 3.0}, []int{1, 2, 3}, nil, false),
+	Entry("some invalid", []interface{}{"a", 1, "b", 2}, []int{1, 2}, []int{0, 2}, false),
+	Entry("all invalid", []string{"a", "b"}, []int{}, []int{0, 1}, false),
+	Entry("overflow", []interface{}{1, 1e30}, []int{1}, []int{1}, false),
+	Entry("typed slice", []int32{1, 2}, []int{1, 2}, nil, false),
+	Entry("array", [2]string{"1", "x"}, []int{1}, []int{1}, false),
+	Entry("empty", []interface{}{}, []int{}, nil, false),
+	Entry("not a slice", "1", nil, nil, true),
+	Entry("nil", nil, nil, nil, true),
+)
+
+var _ = DescribeTable("ParseInt64ArrayBestEffort",
+	func(value interface{}, expectedResult []int64, expectedSkipped []int, expectError bool) {
+		result, skipped, err := parse.ParseInt64ArrayBestEffort(context.Background(), value)
+		if expectError {
+			Expect(err).NotTo(BeNil())
+			return
+		}
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal(expectedResult))
+		Expect(skippedIndexes(skipped)).To(Equal(expectedSkipped))
+	},
+	Entry("all valid", []interface{}{1, "2"}, []int64{1, 2}, nil, false),
+	Entry("some invalid", []interface{}{1, "x", 3}, []int64{1, 3}, []int{1}, false),
+	Entry("not a slice", 5, nil, nil, true),
+)
+
+var _ = DescribeTable("ParseStringsBestEffort",
+	func(value interface{}, expectedResult []string, expectedSkipped []int, expectError bool) {
+		result, skipped, err := parse.ParseStringsBestEffort(context.Background(), value)
+		if expectError {
+			Expect(err).NotTo(BeNil())
+			return
+		}
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal(expectedResult))
+		Expect(skippedIndexes(skipped)).To(Equal(expectedSkipped))
+	},
+	Entry("all valid", []interface{}{"a", 1, true}, []string{"a", "1", "true"}, nil, false),
+	Entry("some invalid", []interface{}{"a", []int{1}, "b"}, []string{"a", "b"}, []int{1}, false),
+	Entry("string", "a", []string{"a"}, nil, false),
+	Entry(
+		"HasStrings with pointer receiver",
+		&DirectionsWithPointerStrings{vals: []string{"a", "b"}},
+		[]string{"a", "b"},
+		nil,
+		false,
+	),
+	Entry("nil", nil, nil, nil, false),
+	Entry("not a slice", 5, nil, nil, true),
+)
+
+var _ = Describe("SkippedElement", func() {
+	It("contains path and value", func() {
+		_, skipped, err := parse.ParseIntArrayBestEffort(
+			context.Background(),
+			[]interface{}{1, "banana"},
+		)
+		Expect(err).To(BeNil())
+		Expect(skipped).To(HaveLen(1))
+		var parseErr *parse.ParseError
+		Expect(stderrors.As(skipped[0].Err, &parseErr)).To(BeTrue())
+		Expect(parseErr.Path).To(Equal("[1]"))
+		Expect(parseErr.Value).To(Equal("banana"))
+	})
+})
@@ -0,0 +1,23 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse
+
+import (
+	"context"
+)
+
+// ParseBoolArray converts an interface{} value to a bool slice.
+// Accepts any slice or array, e.g. []interface{}, []string or []MyBool.
+// Each element is converted using ParseBool, see ParseSlice.
+// Returns a *ParseError if the value cannot be converted to []bool.
+func ParseBoolArray(ctx context.Context, value interface{}) ([]bool, error) {
+	return ParseSlice[bool](ctx, value)
+}
+
+// ParseBoolArrayDefault converts an interface{} value to a bool slice, returning defaultValue on error.
+// This is a convenience wrapper around ParseBoolArray that never returns an error.
+func ParseBoolArrayDefault(ctx context.Context, value interface{}, defaultValue []bool) []bool {
+	return ParseSliceDefault(ctx, value, defaultValue)
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/parse"
+)
+
+var _ = DescribeTable("ParseBoolArray",
+	func(ctx context.Context, input interface{}, expectedResult []bool, hasError bool) {
+		result, err := parse.ParseBoolArray(ctx, input)
+		Expect(result).To(Equal(expectedResult))
+		if hasError {
+			Expect(err).NotTo(BeNil())
+		} else {
+			Expect(err).To(BeNil())
+		}
+	},
+	Entry("[]bool", context.Background(), []bool{true, false}, []bool{true, false}, false),
+	Entry(
+		"[]string",
+		context.Background(),
+		[]string{"true", "false"},
+		[]bool{true, false},
+		false,
+	),
+	Entry("[]MyBool", context.Background(), []MyBool{true}, []bool{true}, false),
+	Entry("invalid element", context.Background(), []string{"yes"}, nil, true),
+	Entry(
+		"extended mode",
+		parse.WithBoolMode(context.Background(), parse.BoolModeExtended),
+		[]interface{}{"yes", 0},
+		[]bool{true, false},
+		false,
+	),
+)
+
+var _ = DescribeTable("ParseBoolArrayDefault",
+	func(input interface{}, defaultValue []bool, expectedResult []bool) {
+		result := parse.ParseBoolArrayDefault(context.Background(), input, defaultValue)
+		Expect(result).To(Equal(expectedResult))
+	},
+	Entry("valid", []string{"true"}, []bool{false}, []bool{true}),
+	Entry("invalid", "x", []bool{false}, []bool{false}),
+)
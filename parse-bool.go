@@ -6,41 +6,187 @@ package parse
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"math"
+	"reflect"
 	"strings"
 
 	"github.com/bborbe/errors"
 )
 
+// BoolMode defines which values ParseBool accepts.
+type BoolMode int
+
+const (
+	// BoolModeDefault accepts bool and the case-insensitive strings "true" and "false".
+	BoolModeDefault BoolMode = iota
+	// BoolModeExtended additionally accepts the case-insensitive strings 1/0, yes/no, on/off,
+	// y/n, t/f and enabled/disabled as well as all integer and float types, where non-zero is true.
+	BoolModeExtended
+)
+
+var (
+	extendedTruthyWords = []string{"1", "yes", "on", "y", "t", "enabled"}
+	extendedFalsyWords  = []string{"0", "no", "off", "n", "f", "disabled"}
+)
+
+type boolModeContextKey struct{}
+
+type boolWordsContextKey struct{}
+
+type boolWords struct {
+	truthy []string
+	falsy  []string
+}
+
+// WithBoolMode returns a copy of ctx that makes ParseBool accept the values of mode.
+func WithBoolMode(ctx context.Context, mode BoolMode) context.Context {
+	return context.WithValue(ctx, boolModeContextKey{}, mode)
+}
+
+// BoolModeFromContext returns the BoolMode stored in ctx or BoolModeDefault.
+func BoolModeFromContext(ctx context.Context) BoolMode {
+	mode, ok := ctx.Value(boolModeContextKey{}).(BoolMode)
+	if !ok {
+		return BoolModeDefault
+	}
+	return mode
+}
+
+// WithBoolWords returns a copy of ctx that makes ParseBool accept the given case-insensitive
+// truthy and falsy words in addition to the words of the configured BoolMode.
+// Words registered by earlier calls stay registered.
+func WithBoolWords(ctx context.Context, truthy []string, falsy []string) context.Context {
+	words := boolWordsFromContext(ctx)
+	return context.WithValue(ctx, boolWordsContextKey{}, boolWords{
+		truthy: append(append([]string{}, words.truthy...), truthy...),
+		falsy:  append(append([]string{}, words.falsy...), falsy...),
+	})
+}
+
+func boolWordsFromContext(ctx context.Context) boolWords {
+	words, _ := ctx.Value(boolWordsContextKey{}).(boolWords)
+	return words
+}
+
 // ParseBool converts an interface{} value to a bool.
 // Supported types: bool, string (case-insensitive "true"/"false"), fmt.Stringer.
 // String values are converted to lowercase and compared against "true" and "false".
-// Returns an error if the value cannot be converted to bool.
+// With BoolModeExtended (see WithBoolMode) the words 1/0, yes/no, on/off, y/n, t/f and
+// enabled/disabled as well as all integer and float types and json.Number are accepted, where
+// non-zero is true.
+// Additional words can be registered with WithBoolWords.
+// Other types are formatted with fmt.Sprintf and parsed, unless strict mode is enabled.
+// Returns a *ParseError if the value cannot be converted to bool.
 func ParseBool(ctx context.Context, value interface{}) (bool, error) {
+	result, err := parseBool(ctx, value)
+	if err != nil {
+		return false, newParseError(value, "bool", err)
+	}
+	return result, nil
+}
+
+func parseBool(ctx context.Context, value interface{}) (bool, error) {
+	value = indirect(value)
+	if value == nil {
+		return false, nilValueError(ctx)
+	}
 	switch v := value.(type) {
 	case bool:
 		return v, nil
 	case string:
-		switch strings.ToLower(v) {
-		case "true":
-			return true, nil
-		case "false":
-			return false, nil
+		return parseBoolString(ctx, v)
+	case json.Number:
+		if BoolModeFromContext(ctx) == BoolModeExtended {
+			if f, err := v.Float64(); err == nil {
+				if result, ok := numberToBool(f); ok {
+					return result, nil
+				}
+			}
 		}
-		return false, errors.Errorf(ctx, "invalid type")
+		return parseBoolString(ctx, string(v))
 	case fmt.Stringer:
-		return ParseBool(ctx, v.String())
+		return parseBool(ctx, v.String())
 	default:
-		return ParseBool(ctx, fmt.Sprintf("%v", value))
+		if BoolModeFromContext(ctx) == BoolModeExtended {
+			if result, ok := numberToBool(value); ok {
+				return result, nil
+			}
+		}
+		if basic, ok := toBasicKind(value); ok {
+			return parseBool(ctx, basic)
+		}
+		if IsStrict(ctx) {
+			return false, errUnsupportedType(ctx, value)
+		}
+		return parseBool(ctx, fmt.Sprintf("%v", value))
 	}
 }
 
 // ParseBoolDefault converts an interface{} value to a bool, returning defaultValue on error.
 // This is a convenience wrapper around ParseBool that never returns an error.
 func ParseBoolDefault(ctx context.Context, value interface{}, defaultValue bool) bool {
+	if isNilDefault(ctx, value) {
+		return defaultValue
+	}
 	result, err := ParseBool(ctx, value)
 	if err != nil {
 		return defaultValue
 	}
 	return result
 }
+
+func parseBoolString(ctx context.Context, value string) (bool, error) {
+	lower := strings.ToLower(value)
+	switch lower {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	}
+	if BoolModeFromContext(ctx) == BoolModeExtended {
+		if containsWord(extendedTruthyWords, lower) {
+			return true, nil
+		}
+		if containsWord(extendedFalsyWords, lower) {
+			return false, nil
+		}
+	}
+	words := boolWordsFromContext(ctx)
+	if containsWord(words.truthy, lower) {
+		return true, nil
+	}
+	if containsWord(words.falsy, lower) {
+		return false, nil
+	}
+	return false, errors.Wrapf(ctx, ErrInvalidType, "unknown bool value '%s'", value)
+}
+
+func containsWord(words []string, lower string) bool {
+	for _, word := range words {
+		if strings.ToLower(word) == lower {
+			return true
+		}
+	}
+	return false
+}
+
+// numberToBool converts integer and float kinds to bool, non-zero is true.
+// NaN and non-numeric values are reported as not ok.
+func numberToBool(value interface{}) (bool, bool) {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() != 0, true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return v.Uint() != 0, true
+	case reflect.Float32, reflect.Float64:
+		if math.IsNaN(v.Float()) {
+			return false, false
+		}
+		return v.Float() != 0, true
+	default:
+		return false, false
+	}
+}
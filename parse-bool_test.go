@@ -6,6 +6,7 @@ package parse_test
 
 import (
 	"context"
+	"encoding/json"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -54,3 +55,72 @@ var _ = DescribeTable("ParseBoolDefault",
 	Entry("nil returns default", nil, true, true),
 	Entry("unsupported type returns default", []int{1, 2}, false, false),
 )
+
+var _ = DescribeTable("ParseBool with BoolModeExtended",
+	func(value interface{}, expectedResult bool, expectError bool) {
+		ctx := parse.WithBoolMode(context.Background(), parse.BoolModeExtended)
+		result, err := parse.ParseBool(ctx, value)
+		if expectError {
+			Expect(err).NotTo(BeNil())
+			Expect(result).To(Equal(false))
+		} else {
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(expectedResult))
+		}
+	},
+	Entry("true", true, true, false),
+	Entry("true string", "true", true, false),
+	Entry("1 string", "1", true, false),
+	Entry("0 string", "0", false, false),
+	Entry("yes", "yes", true, false),
+	Entry("No", "No", false, false),
+	Entry("on", "on", true, false),
+	Entry("OFF", "OFF", false, false),
+	Entry("y", "y", true, false),
+	Entry("n", "n", false, false),
+	Entry("t", "t", true, false),
+	Entry("f", "f", false, false),
+	Entry("enabled", "enabled", true, false),
+	Entry("Disabled", "Disabled", false, false),
+	Entry("int 1", 1, true, false),
+	Entry("int 0", 0, false, false),
+	Entry("int 2", 2, true, false),
+	Entry("int64 -1", int64(-1), true, false),
+	Entry("uint8 0", uint8(0), false, false),
+	Entry("float64 0.5", 0.5, true, false),
+	Entry("float32 0", float32(0), false, false),
+	Entry("custom int", MyInt(1), true, false),
+	Entry("json.Number 2", json.Number("2"), true, false),
+	Entry("json.Number 0.0", json.Number("0.0"), false, false),
+	Entry("json.Number -1.5", json.Number("-1.5"), true, false),
+	Entry("stringer yes", MyStringer("yes"), true, false),
+	Entry("invalid", "maybe", false, true),
+	Entry("2 string", "2", false, true),
+)
+
+var _ = Describe("ParseBool", func() {
+	It("rejects numbers in BoolModeDefault", func() {
+		_, err := parse.ParseBool(context.Background(), 2)
+		Expect(err).NotTo(BeNil())
+	})
+	It("rejects extended words in BoolModeDefault", func() {
+		_, err := parse.ParseBool(context.Background(), "yes")
+		Expect(err).NotTo(BeNil())
+	})
+	It("accepts registered words", func() {
+		ctx := parse.WithBoolWords(context.Background(), []string{"ja"}, []string{"nein"})
+		ctx = parse.WithBoolWords(ctx, []string{"Oui"}, []string{"non"})
+		Expect(parse.ParseBool(ctx, "JA")).To(BeTrue())
+		Expect(parse.ParseBool(ctx, "nein")).To(BeFalse())
+		Expect(parse.ParseBool(ctx, "oui")).To(BeTrue())
+		Expect(parse.ParseBool(ctx, "non")).To(BeFalse())
+		_, err := parse.ParseBool(ctx, "yes")
+		Expect(err).NotTo(BeNil())
+	})
+	It("accepts registered words in addition to BoolModeExtended", func() {
+		ctx := parse.WithBoolMode(context.Background(), parse.BoolModeExtended)
+		ctx = parse.WithBoolWords(ctx, []string{"ja"}, []string{"nein"})
+		Expect(parse.ParseBool(ctx, "ja")).To(BeTrue())
+		Expect(parse.ParseBool(ctx, "yes")).To(BeTrue())
+	})
+})
@@ -0,0 +1,98 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bborbe/errors"
+)
+
+type collectErrorsContextKey struct{}
+
+// WithCollectErrors returns a copy of ctx that makes the array parsers, Parse and Decode report
+// all failing elements and fields instead of stopping at the first one.
+// The returned error is a ParseErrors holding one *ParseError per failure, each with its Path,
+// e.g. "items[3].price".
+func WithCollectErrors(ctx context.Context) context.Context {
+	return context.WithValue(ctx, collectErrorsContextKey{}, true)
+}
+
+// IsCollectErrors returns true if collect-all mode is enabled in ctx.
+func IsCollectErrors(ctx context.Context) bool {
+	collect, _ := ctx.Value(collectErrorsContextKey{}).(bool)
+	return collect
+}
+
+// ParseErrors is returned in collect-all mode, see WithCollectErrors.
+// errors.Is and errors.As check each contained *ParseError.
+type ParseErrors []*ParseError
+
+func (e ParseErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, parseError := range e {
+		messages[i] = parseError.Error()
+	}
+	return fmt.Sprintf("%d parse errors: %s", len(e), strings.Join(messages, "; "))
+}
+
+// Unwrap returns the contained errors.
+func (e ParseErrors) Unwrap() []error {
+	result := make([]error, len(e))
+	for i, parseError := range e {
+		result[i] = parseError
+	}
+	return result
+}
+
+// errorCollector collects the errors of elements and fields.
+// In collect-all mode all errors are collected, otherwise only the first one.
+type errorCollector struct {
+	collect bool
+	errs    ParseErrors
+}
+
+func newErrorCollector(ctx context.Context) *errorCollector {
+	return &errorCollector{
+		collect: IsCollectErrors(ctx),
+	}
+}
+
+// add records err located at path and reports whether processing should continue.
+func (c *errorCollector) add(err error, path string) bool {
+	err = withPathPrefix(err, path)
+	switch e := err.(type) {
+	case ParseErrors:
+		c.errs = append(c.errs, e...)
+	default:
+		var parseError *ParseError
+		if errors.As(e, &parseError) {
+			c.errs = append(c.errs, parseError)
+		} else {
+			c.errs = append(c.errs, &ParseError{Path: path, Cause: e})
+		}
+	}
+	return c.collect
+}
+
+// err returns nil if nothing was collected, the first error if collect-all mode is disabled and
+// ParseErrors otherwise.
+func (c *errorCollector) err() error {
+	switch {
+	case len(c.errs) == 0:
+		return nil
+	case !c.collect:
+		return c.errs[0]
+	default:
+		return c.errs
+	}
+}
+
+func isParseErrors(err error) bool {
+	_, ok := err.(ParseErrors)
+	return ok
+}
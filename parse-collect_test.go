@@ -0,0 +1,112 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse_test
+
+import (
+	"context"
+	stderrors "errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/parse"
+)
+
+func parseErrorPaths(err error) []string {
+	var parseErrors parse.ParseErrors
+	Expect(stderrors.As(err, &parseErrors)).To(BeTrue())
+	result := make([]string, len(parseErrors))
+	for i, parseError := range parseErrors {
+		result[i] = parseError.Path
+	}
+	return result
+}
+
+var _ = Describe("WithCollectErrors", func() {
+	var ctx context.Context
+	BeforeEach(func() {
+		ctx = parse.WithCollectErrors(context.Background())
+	})
+	It("is disabled by default", func() {
+		Expect(parse.IsCollectErrors(context.Background())).To(BeFalse())
+	})
+	It("is enabled", func() {
+		Expect(parse.IsCollectErrors(ctx)).To(BeTrue())
+	})
+	DescribeTable("array parsers",
+		func(parseFn func(ctx context.Context, value interface{}) error, expectedPaths []string) {
+			err := parseFn(ctx, []interface{}{"a", 1, "b", 2, "c"})
+			Expect(err).NotTo(BeNil())
+			Expect(stderrors.Is(err, parse.ErrInvalidType)).To(BeTrue())
+			Expect(parseErrorPaths(err)).To(Equal(expectedPaths))
+		},
+		Entry("ParseIntArray", func(ctx context.Context, value interface{}) error {
+			_, err := parse.ParseIntArray(ctx, value)
+			return err
+		}, []string{"[0]", "[2]", "[4]"}),
+		Entry("ParseInt64Array", func(ctx context.Context, value interface{}) error {
+			_, err := parse.ParseInt64Array(ctx, value)
+			return err
+		}, []string{"[0]", "[2]", "[4]"}),
+		Entry("ParseUintArray", func(ctx context.Context, value interface{}) error {
+			_, err := parse.ParseUintArray(ctx, value)
+			return err
+		}, []string{"[0]", "[2]", "[4]"}),
+		Entry("ParseUint32Array", func(ctx context.Context, value interface{}) error {
+			_, err := parse.ParseUint32Array(ctx, value)
+			return err
+		}, []string{"[0]", "[2]", "[4]"}),
+		Entry("ParseUint64Array", func(ctx context.Context, value interface{}) error {
+			_, err := parse.ParseUint64Array(ctx, value)
+			return err
+		}, []string{"[0]", "[2]", "[4]"}),
+		Entry("Parse[[]float64]", func(ctx context.Context, value interface{}) error {
+			_, err := parse.Parse[[]float64](ctx, value)
+			return err
+		}, []string{"[0]", "[2]", "[4]"}),
+	)
+	It("collects errors of ParseStrings", func() {
+		_, err := parse.ParseStrings(ctx, []interface{}{"a", []int{1}, "b", []int{2}})
+		Expect(parseErrorPaths(err)).To(Equal([]string{"[1]", "[3]"}))
+	})
+	It("collects errors of nested structs", func() {
+		var config DecodeConfig
+		err := parse.Decode(ctx, map[string]interface{}{
+			"id":   "banana",
+			"port": "banana",
+			"items": []interface{}{
+				map[string]interface{}{"name": "a", "price": "banana"},
+				map[string]interface{}{"price": 1},
+				map[string]interface{}{"name": "c", "price": "banana"},
+			},
+			"labels": map[string]interface{}{"a": 1, "b": "banana"},
+		}, &config)
+		Expect(err).NotTo(BeNil())
+		Expect(parseErrorPaths(err)).To(Equal([]string{
+			"id",
+			"port",
+			"host",
+			"items[0].price",
+			"items[1].name",
+			"items[2].price",
+			"labels.b",
+		}))
+		Expect(stderrors.Is(err, parse.ErrRequired)).To(BeTrue())
+		Expect(err.Error()).To(HavePrefix("7 parse errors: "))
+	})
+	It("returns nil if all elements are valid", func() {
+		result, err := parse.ParseIntArray(ctx, []interface{}{1, "2"})
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal([]int{1, 2}))
+	})
+	It("returns first error only without collect mode", func() {
+		_, err := parse.ParseIntArray(context.Background(), []interface{}{"a", "b"})
+		var parseErr *parse.ParseError
+		Expect(stderrors.As(err, &parseErr)).To(BeTrue())
+		Expect(parseErr.Path).To(Equal("[0]"))
+		var parseErrors parse.ParseErrors
+		Expect(stderrors.As(err, &parseErrors)).To(BeFalse())
+	})
+})
@@ -0,0 +1,261 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/bborbe/errors"
+)
+
+// ErrRequired is wrapped by the error returned by Decode for missing required fields.
+var ErrRequired = stderrors.New("required")
+
+// Decode converts data into the struct pointed to by target.
+// Each exported field is converted with the typed parsers via Parse. The key of a field is its
+// name, matched case-insensitively if there is no exact match, and can be set with a `parse` tag:
+//
+//	Port    int       `parse:"port,default=8080"`
+//	Name    string    `parse:"name,required"`
+//	Start   time.Time `parse:"start,layout=2006-01-02"`
+//	Ignored string    `parse:"-"`
+//
+// Options:
+//   - default=<value> is parsed if the key is missing
+//   - required returns a *ParseError wrapping ErrRequired if the key is missing
+//   - layout=<layout> sets the time layout of time.Time fields, see WithTimeLayout
+//   - omitempty is ignored by Decode and omits zero values in Encode
+//
+// Nested structs are decoded from nested maps, embedded structs without a tag name are decoded
+// from data itself. Slices, maps and pointers of supported types are converted element by
+// element. Fields with missing keys keep their current value.
+// Returns a *ParseError with the path of the failing field, e.g. "items[3].price",
+// or ParseErrors with all failing fields if WithCollectErrors is set.
+func Decode(ctx context.Context, data map[string]interface{}, target interface{}) error {
+	v := reflect.ValueOf(target)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return newParseError(
+			target,
+			fmt.Sprintf("%T", target),
+			errors.Wrapf(ctx, ErrInvalidType, "target must be a non-nil pointer to a struct"),
+		)
+	}
+	return decodeStruct(ctx, data, v.Elem())
+}
+
+// fieldTag holds the parsed `parse` tag of a struct field.
+type fieldTag struct {
+	name         string
+	skip         bool
+	required     bool
+	omitEmpty    bool
+	hasDefault   bool
+	defaultValue string
+	layout       string
+}
+
+// parseFieldTag parses the `parse` tag of field.
+// Commas inside default and layout values are kept if the following part is no known option,
+// e.g. `parse:"date,layout=Mon, 02 Jan 2006"`.
+func parseFieldTag(field reflect.StructField) fieldTag {
+	tag, ok := field.Tag.Lookup("parse")
+	if tag == "-" {
+		return fieldTag{skip: true}
+	}
+	result := fieldTag{name: field.Name}
+	if !ok {
+		return result
+	}
+	parts := strings.Split(tag, ",")
+	if parts[0] != "" {
+		result.name = parts[0]
+	}
+	var last *string
+	for _, part := range parts[1:] {
+		switch {
+		case part == "required":
+			result.required = true
+			last = nil
+		case part == "omitempty":
+			result.omitEmpty = true
+			last = nil
+		case strings.HasPrefix(part, "default="):
+			result.hasDefault = true
+			result.defaultValue = strings.TrimPrefix(part, "default=")
+			last = &result.defaultValue
+		case strings.HasPrefix(part, "layout="):
+			result.layout = strings.TrimPrefix(part, "layout=")
+			last = &result.layout
+		case last != nil:
+			*last += "," + part
+		}
+	}
+	return result
+}
+
+// hasTagName reports whether field has a `parse` tag with an explicit name.
+func hasTagName(field reflect.StructField) bool {
+	tag := field.Tag.Get("parse")
+	return tag != "" && tag != "-" && !strings.HasPrefix(tag, ",")
+}
+
+// decodeStruct sets the fields of target from data.
+func decodeStruct(ctx context.Context, data map[string]interface{}, target reflect.Value) error {
+	targetType := target.Type()
+	errs := newErrorCollector(ctx)
+	for i := 0; i < targetType.NumField(); i++ {
+		field := targetType.Field(i)
+		tag := parseFieldTag(field)
+		if tag.skip {
+			continue
+		}
+		if field.Anonymous && !hasTagName(field) {
+			if embedded, ok := embeddedStruct(target.Field(i), field); ok {
+				if err := decodeStruct(ctx, data, embedded); err != nil && !errs.add(err, "") {
+					break
+				}
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if err := decodeField(ctx, data, target.Field(i), field, tag); err != nil {
+			if !errs.add(err, tag.name) {
+				break
+			}
+		}
+	}
+	return errs.err()
+}
+
+// embeddedStruct returns the settable struct of the embedded field, allocating nil pointers.
+func embeddedStruct(value reflect.Value, field reflect.StructField) (reflect.Value, bool) {
+	switch {
+	case field.Type.Kind() == reflect.Struct:
+		return value, true
+	case field.Type.Kind() == reflect.Pointer && field.Type.Elem().Kind() == reflect.Struct:
+		if value.IsNil() {
+			if !field.IsExported() {
+				return reflect.Value{}, false
+			}
+			value.Set(reflect.New(field.Type.Elem()))
+		}
+		return value.Elem(), true
+	default:
+		return reflect.Value{}, false
+	}
+}
+
+// decodeField sets target from the value stored under the tag name in data.
+func decodeField(
+	ctx context.Context,
+	data map[string]interface{},
+	target reflect.Value,
+	field reflect.StructField,
+	tag fieldTag,
+) error {
+	value, ok := lookupKey(data, tag.name)
+	if !ok {
+		switch {
+		case tag.hasDefault:
+			value = tag.defaultValue
+		case tag.required:
+			return newParseError(
+				nil,
+				field.Type.String(),
+				errors.Wrapf(ctx, ErrRequired, "field %s is required", field.Name),
+			)
+		default:
+			return nil
+		}
+	}
+	if tag.layout != "" {
+		ctx = WithTimeLayout(ctx, tag.layout)
+	}
+	result, err := parseValue(ctx, value, field.Type)
+	if err != nil {
+		return err
+	}
+	target.Set(result)
+	return nil
+}
+
+// lookupKey returns the value of key in data. If there is no exact match, the first key in
+// sorted order matching case-insensitively is used.
+func lookupKey(data map[string]interface{}, key string) (interface{}, bool) {
+	if value, ok := data[key]; ok {
+		return value, true
+	}
+	var match string
+	var found bool
+	for k := range data {
+		if strings.EqualFold(k, key) && (!found || k < match) {
+			match = k
+			found = true
+		}
+	}
+	if !found {
+		return nil, false
+	}
+	return data[match], true
+}
+
+// parseStructValue converts a map value into a struct of targetType using Decode.
+func parseStructValue(
+	ctx context.Context,
+	value interface{},
+	targetType reflect.Type,
+) (reflect.Value, error) {
+	v := indirect(value)
+	if v == nil {
+		if err := nilValueError(ctx); err != nil {
+			return reflect.Value{}, newParseError(value, targetType.String(), err)
+		}
+		return reflect.Zero(targetType), nil
+	}
+	if reflect.TypeOf(v) == targetType {
+		return reflect.ValueOf(v), nil
+	}
+	v, err := decodeJSONValue(ctx, v)
+	if err != nil {
+		return reflect.Value{}, newParseError(value, targetType.String(), err)
+	}
+	data, err := toStringKeyMap(ctx, v)
+	if err != nil {
+		return reflect.Value{}, newParseError(value, targetType.String(), err)
+	}
+	result := reflect.New(targetType).Elem()
+	if err := decodeStruct(ctx, data, result); err != nil {
+		return reflect.Value{}, err
+	}
+	return result, nil
+}
+
+// toStringKeyMap converts a map with arbitrary keys, like map[interface{}]interface{} produced
+// by YAML decoders, to map[string]interface{} using ParseString for the keys.
+func toStringKeyMap(ctx context.Context, value interface{}) (map[string]interface{}, error) {
+	if data, ok := value.(map[string]interface{}); ok {
+		return data, nil
+	}
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Map {
+		return nil, errUnsupportedType(ctx, value)
+	}
+	result := make(map[string]interface{}, v.Len())
+	iter := v.MapRange()
+	for iter.Next() {
+		key, err := ParseString(ctx, iter.Key().Interface())
+		if err != nil {
+			return nil, err
+		}
+		result[key] = iter.Value().Interface()
+	}
+	return result, nil
+}
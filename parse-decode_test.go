@@ -0,0 +1,285 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse_test
+
+import (
+	"context"
+	stderrors "errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/parse"
+)
+
+type DecodeItem struct {
+	Name  string  `parse:"name,required"`
+	Price float64 `parse:"price"`
+}
+
+type DecodeBase struct {
+	ID int64 `parse:"id"`
+}
+
+type DecodeConfig struct {
+	DecodeBase
+	Port    int            `parse:"port,default=8080"`
+	Host    string         `parse:"host,required"`
+	Debug   bool           `parse:"debug"`
+	Start   time.Time      `parse:"start,layout=2006-01-02"`
+	Timeout *int           `parse:"timeout"`
+	Tags    []string       `parse:"tags"`
+	Items   []DecodeItem   `parse:"items"`
+	Labels  map[string]int `parse:"labels"`
+	Owner   DecodeItem     `parse:"owner"`
+	Extra   interface{}    `parse:"extra"`
+	Ignored string         `parse:"-"`
+	Title   string         // matched case-insensitively
+}
+
+var _ = Describe("Decode", func() {
+	var ctx context.Context
+	var config DecodeConfig
+	var data map[string]interface{}
+	var err error
+	BeforeEach(func() {
+		ctx = context.Background()
+		config = DecodeConfig{}
+		data = map[string]interface{}{
+			"id":      "7",
+			"host":    "localhost",
+			"debug":   "true",
+			"start":   "2023-12-25",
+			"timeout": 30,
+			"tags":    []interface{}{"a", "b"},
+			"items": []interface{}{
+				map[string]interface{}{"name": "apple", "price": "1.5"},
+				map[interface{}]interface{}{"name": "pear", "price": 2},
+			},
+			"labels":  map[string]interface{}{"env": "1"},
+			"owner":   map[string]interface{}{"name": "ben"},
+			"extra":   []interface{}{1, "x"},
+			"Ignored": "ignored",
+			"TITLE":   "hello",
+		}
+	})
+	JustBeforeEach(func() {
+		err = parse.Decode(ctx, data, &config)
+	})
+	It("returns no error", func() {
+		Expect(err).To(BeNil())
+	})
+	It("decodes all fields", func() {
+		timeout := 30
+		Expect(config).To(Equal(DecodeConfig{
+			DecodeBase: DecodeBase{ID: 7},
+			Port:       8080,
+			Host:       "localhost",
+			Debug:      true,
+			Start:      time.Date(2023, 12, 25, 0, 0, 0, 0, time.UTC),
+			Timeout:    &timeout,
+			Tags:       []string{"a", "b"},
+			Items: []DecodeItem{
+				{Name: "apple", Price: 1.5},
+				{Name: "pear", Price: 2},
+			},
+			Labels: map[string]int{"env": 1},
+			Owner:  DecodeItem{Name: "ben"},
+			Extra:  []interface{}{1, "x"},
+			Title:  "hello",
+		}))
+	})
+	Context("missing required field", func() {
+		BeforeEach(func() {
+			delete(data, "host")
+		})
+		It("returns ErrRequired with path", func() {
+			Expect(err).NotTo(BeNil())
+			Expect(stderrors.Is(err, parse.ErrRequired)).To(BeTrue())
+			Expect(stderrors.Is(err, parse.ErrInvalidType)).To(BeFalse())
+			var parseErr *parse.ParseError
+			Expect(stderrors.As(err, &parseErr)).To(BeTrue())
+			Expect(parseErr.Path).To(Equal("host"))
+		})
+	})
+	Context("missing required field in slice element", func() {
+		BeforeEach(func() {
+			data["items"] = []interface{}{
+				map[string]interface{}{"name": "apple"},
+				map[string]interface{}{"price": 1},
+			}
+		})
+		It("returns path of element", func() {
+			var parseErr *parse.ParseError
+			Expect(stderrors.As(err, &parseErr)).To(BeTrue())
+			Expect(parseErr.Path).To(Equal("items[1].name"))
+		})
+	})
+	Context("invalid nested value", func() {
+		BeforeEach(func() {
+			data["items"] = []interface{}{map[string]interface{}{"name": "a", "price": "banana"}}
+		})
+		It("returns ErrInvalidType with path", func() {
+			Expect(stderrors.Is(err, parse.ErrInvalidType)).To(BeTrue())
+			var parseErr *parse.ParseError
+			Expect(stderrors.As(err, &parseErr)).To(BeTrue())
+			Expect(parseErr.Path).To(Equal("items[0].price"))
+			Expect(parseErr.Value).To(Equal("banana"))
+		})
+	})
+	Context("invalid map value", func() {
+		BeforeEach(func() {
+			data["labels"] = map[string]interface{}{"env": "banana"}
+		})
+		It("returns path of key", func() {
+			var parseErr *parse.ParseError
+			Expect(stderrors.As(err, &parseErr)).To(BeTrue())
+			Expect(parseErr.Path).To(Equal("labels.env"))
+		})
+	})
+	Context("invalid time", func() {
+		BeforeEach(func() {
+			data["start"] = "2023-12-25T10:00:00Z"
+		})
+		It("returns error", func() {
+			var parseErr *parse.ParseError
+			Expect(stderrors.As(err, &parseErr)).To(BeTrue())
+			Expect(parseErr.Path).To(Equal("start"))
+		})
+	})
+	Context("port set", func() {
+		BeforeEach(func() {
+			data["port"] = 443
+		})
+		It("ignores default", func() {
+			Expect(err).To(BeNil())
+			Expect(config.Port).To(Equal(443))
+		})
+	})
+	Context("preset value", func() {
+		BeforeEach(func() {
+			config.Ignored = "keep"
+			config.Debug = true
+			delete(data, "debug")
+		})
+		It("keeps fields without key", func() {
+			Expect(err).To(BeNil())
+			Expect(config.Ignored).To(Equal("keep"))
+			Expect(config.Debug).To(BeTrue())
+		})
+	})
+})
+
+var _ = Describe("Decode target", func() {
+	It("returns error for non pointer", func() {
+		err := parse.Decode(context.Background(), map[string]interface{}{}, DecodeItem{})
+		Expect(stderrors.Is(err, parse.ErrInvalidType)).To(BeTrue())
+	})
+	It("returns error for nil pointer", func() {
+		err := parse.Decode(context.Background(), map[string]interface{}{}, (*DecodeItem)(nil))
+		Expect(stderrors.Is(err, parse.ErrInvalidType)).To(BeTrue())
+	})
+	It("decodes embedded pointer struct", func() {
+		type target struct {
+			*DecodeBase
+			Name string `parse:"name"`
+		}
+		var result target
+		err := parse.Decode(
+			context.Background(),
+			map[string]interface{}{"id": 3, "name": "x"},
+			&result,
+		)
+		Expect(err).To(BeNil())
+		Expect(result.DecodeBase).To(Equal(&DecodeBase{ID: 3}))
+		Expect(result.Name).To(Equal("x"))
+	})
+	It("decodes embedded struct with tag name as nested struct", func() {
+		type target struct {
+			DecodeBase `parse:"base"`
+		}
+		var result target
+		err := parse.Decode(
+			context.Background(),
+			map[string]interface{}{"base": map[string]interface{}{"id": 3}},
+			&result,
+		)
+		Expect(err).To(BeNil())
+		Expect(result.ID).To(Equal(int64(3)))
+	})
+	It("decodes sized integer and float fields", func() {
+		type target struct {
+			Level  int32
+			Small  int8
+			Medium int16
+			Byte   uint8
+			Port   uint16
+			Ratio  float32
+		}
+		var result target
+		err := parse.Decode(
+			context.Background(),
+			map[string]interface{}{
+				"Level":  5,
+				"Small":  "-8",
+				"Medium": 16.0,
+				"Byte":   "255",
+				"Port":   8080,
+				"Ratio":  "0.5",
+			},
+			&result,
+		)
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal(target{
+			Level:  5,
+			Small:  -8,
+			Medium: 16,
+			Byte:   255,
+			Port:   8080,
+			Ratio:  0.5,
+		}))
+	})
+	It("returns ErrOutOfRange with path for sized field overflow", func() {
+		type target struct {
+			Port uint16 `parse:"port"`
+		}
+		var result target
+		err := parse.Decode(
+			context.Background(),
+			map[string]interface{}{"port": 70000},
+			&result,
+		)
+		Expect(stderrors.Is(err, parse.ErrOutOfRange)).To(BeTrue())
+		var parseErr *parse.ParseError
+		Expect(stderrors.As(err, &parseErr)).To(BeTrue())
+		Expect(parseErr.Path).To(Equal("port"))
+	})
+	It("keeps commas in layout", func() {
+		type target struct {
+			Date time.Time `parse:"date,layout=Mon, 02 Jan 2006"`
+		}
+		var result target
+		err := parse.Decode(
+			context.Background(),
+			map[string]interface{}{"date": "Mon, 25 Dec 2023"},
+			&result,
+		)
+		Expect(err).To(BeNil())
+		Expect(result.Date).To(Equal(time.Date(2023, 12, 25, 0, 0, 0, 0, time.UTC)))
+	})
+})
+
+var _ = Describe("WithTimeLayout", func() {
+	It("returns RFC3339 by default", func() {
+		Expect(parse.TimeLayoutFromContext(context.Background())).To(Equal(time.RFC3339))
+	})
+	It("is used by Parse", func() {
+		ctx := parse.WithTimeLayout(context.Background(), "2006-01-02")
+		result, err := parse.Parse[time.Time](ctx, "2023-12-25")
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal(time.Date(2023, 12, 25, 0, 0, 0, 0, time.UTC)))
+	})
+})
@@ -0,0 +1,221 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bborbe/errors"
+)
+
+// day and week are the extended units accepted by ParseDuration as "d" and "w".
+const (
+	day  = 24 * time.Hour
+	week = 7 * day
+)
+
+type durationUnitContextKey struct{}
+
+// WithDurationUnit returns a copy of ctx that makes ParseDuration read bare numbers like 30 or
+// "1.5" in unit, e.g. time.Millisecond, instead of seconds.
+func WithDurationUnit(ctx context.Context, unit time.Duration) context.Context {
+	return context.WithValue(ctx, durationUnitContextKey{}, unit)
+}
+
+// DurationUnitFromContext returns the unit stored in ctx or time.Second.
+func DurationUnitFromContext(ctx context.Context) time.Duration {
+	if unit, ok := ctx.Value(durationUnitContextKey{}).(time.Duration); ok && unit > 0 {
+		return unit
+	}
+	return time.Second
+}
+
+// ParseDuration converts an interface{} value to a time.Duration.
+// Supported types: time.Duration, Go duration strings like "1h30m", strings with the extended
+// units "d" (24h) and "w" (7d) like "3d", "2w" or "1d12h", and bare numbers in the unit of
+// WithDurationUnit, by default seconds: integer and float types, json.Number, numeric strings,
+// fmt.Stringer and named types of them, e.g. `type Count int64`.
+// Named int64 types whose name ends with "Duration" like `type Duration time.Duration` are read
+// as time.Duration nanoseconds, not as bare numbers.
+// Other types are converted with ParseString first, e.g. true becomes the invalid duration
+// "true", and types ParseString does not support return a *ParseError matching ErrInvalidType.
+// Returns a *ParseError wrapping an *OverflowError if the value is out of range for
+// time.Duration, NaN or infinite.
+// Returns a *ParseError if the value cannot be converted to time.Duration.
+func ParseDuration(ctx context.Context, value interface{}) (time.Duration, error) {
+	result, err := parseDuration(ctx, value)
+	if err != nil {
+		return 0, newParseError(value, "time.Duration", err)
+	}
+	return result, nil
+}
+
+// ParseDurationDefault converts an interface{} value to a time.Duration,
+// returning defaultValue on error.
+// This is a convenience wrapper around ParseDuration that never returns an error.
+func ParseDurationDefault(
+	ctx context.Context,
+	value interface{},
+	defaultValue time.Duration,
+) time.Duration {
+	if isNilDefault(ctx, value) {
+		return defaultValue
+	}
+	result, err := ParseDuration(ctx, value)
+	if err != nil {
+		return defaultValue
+	}
+	return result
+}
+
+// ParseDurationArray converts an interface{} value to a time.Duration slice.
+// Accepts any slice or array, e.g. []interface{}, []string or []int.
+// Each element is converted using ParseDuration, see ParseSlice.
+// Returns a *ParseError if the value cannot be converted to []time.Duration.
+func ParseDurationArray(ctx context.Context, value interface{}) ([]time.Duration, error) {
+	return ParseSlice[time.Duration](ctx, value)
+}
+
+// ParseDurationArrayDefault converts an interface{} value to a time.Duration slice,
+// returning defaultValue on error.
+// This is a convenience wrapper around ParseDurationArray that never returns an error.
+func ParseDurationArrayDefault(
+	ctx context.Context,
+	value interface{},
+	defaultValue []time.Duration,
+) []time.Duration {
+	return ParseSliceDefault(ctx, value, defaultValue)
+}
+
+func parseDuration(ctx context.Context, value interface{}) (time.Duration, error) {
+	value = indirect(value)
+	if value == nil {
+		return 0, nilValueError(ctx)
+	}
+	switch v := value.(type) {
+	case time.Duration:
+		return v, nil
+	case json.Number:
+		return parseDurationString(ctx, string(v), value)
+	}
+	if isNamedDurationType(reflect.TypeOf(value)) {
+		return time.Duration(reflect.ValueOf(value).Int()), nil
+	}
+	unit := DurationUnitFromContext(ctx)
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.Float32, reflect.Float64:
+		return floatToDuration(reflect.ValueOf(value).Float(), unit, value)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		i, err := parseInt64(ctx, value)
+		if err != nil {
+			return 0, err
+		}
+		return intToDuration(i, unit, value)
+	}
+	str, err := parseString(ctx, value)
+	if err != nil {
+		return 0, err
+	}
+	return parseDurationString(ctx, str, value)
+}
+
+// isNamedDurationType reports whether t is a named int64 type like `type Duration time.Duration`.
+// Reflection cannot tell it apart from `type Count int64`, so the name has to end with "Duration".
+func isNamedDurationType(t reflect.Type) bool {
+	return t.Kind() == reflect.Int64 && t.PkgPath() != "" && strings.HasSuffix(t.Name(), "Duration")
+}
+
+// parseDurationString parses a bare number in the unit of ctx or a duration string.
+func parseDurationString(
+	ctx context.Context,
+	str string,
+	value interface{},
+) (time.Duration, error) {
+	str = strings.TrimSpace(str)
+	unit := DurationUnitFromContext(ctx)
+	if i, err := strconv.ParseInt(str, 10, 64); err == nil {
+		return intToDuration(i, unit, value)
+	}
+	if f, err := strconv.ParseFloat(str, 64); err == nil {
+		return floatToDuration(f, unit, value)
+	}
+	rest := strings.TrimLeft(str, "+-")
+	if rest == "" || len(str)-len(rest) > 1 {
+		return 0, errors.Wrapf(ctx, ErrInvalidType, "invalid duration '%s'", str)
+	}
+	var result time.Duration
+	for rest != "" {
+		number := rest[:len(rest)-len(strings.TrimLeft(rest, "0123456789."))]
+		rest = rest[len(number):]
+		symbol := rest[:len(rest)-len(strings.TrimLeft(rest, "abcdefghijklmnopqrstuvwxyzµμ"))]
+		rest = rest[len(symbol):]
+		if number == "" || symbol == "" {
+			return 0, errors.Wrapf(ctx, ErrInvalidType, "invalid duration '%s'", str)
+		}
+		segment, err := parseDurationSegment(ctx, number, symbol, value)
+		if err != nil {
+			return 0, err
+		}
+		if result > math.MaxInt64-segment {
+			return 0, &OverflowError{Value: value, TargetType: "time.Duration"}
+		}
+		result += segment
+	}
+	if strings.HasPrefix(str, "-") {
+		return -result, nil
+	}
+	return result, nil
+}
+
+// parseDurationSegment converts a single number and unit like "3d" or "1.5h".
+func parseDurationSegment(
+	ctx context.Context,
+	number string,
+	symbol string,
+	value interface{},
+) (time.Duration, error) {
+	switch symbol {
+	case "d", "w":
+		f, err := strconv.ParseFloat(number, 64)
+		if err != nil {
+			return 0, errors.Wrapf(ctx, ErrInvalidType, "invalid number '%s'", number)
+		}
+		if symbol == "w" {
+			return floatToDuration(f, week, value)
+		}
+		return floatToDuration(f, day, value)
+	}
+	result, err := time.ParseDuration(number + symbol)
+	if err != nil {
+		return 0, errors.Wrapf(ctx, ErrInvalidType, "%v", err)
+	}
+	return result, nil
+}
+
+// intToDuration multiplies i by unit and reports an *OverflowError if the result exceeds
+// time.Duration.
+func intToDuration(i int64, unit time.Duration, value interface{}) (time.Duration, error) {
+	if i > math.MaxInt64/int64(unit) || i < math.MinInt64/int64(unit) {
+		return 0, &OverflowError{Value: value, TargetType: "time.Duration"}
+	}
+	return time.Duration(i) * unit, nil
+}
+
+// floatToDuration multiplies f by unit, rounded to nanoseconds, and reports an *OverflowError
+// if the result exceeds time.Duration, is NaN or infinite.
+func floatToDuration(f float64, unit time.Duration, value interface{}) (time.Duration, error) {
+	result := math.Round(f * float64(unit))
+	if math.IsNaN(result) || result >= math.MaxInt64 || result < math.MinInt64 {
+		return 0, &OverflowError{Value: value, TargetType: "time.Duration"}
+	}
+	return time.Duration(result), nil
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse_test
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"math"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/parse"
+)
+
+type TimeoutDuration time.Duration
+
+type Count int64
+
+type Retries int
+
+var _ = DescribeTable("ParseDuration",
+	func(value interface{}, expectedResult time.Duration, expectError bool) {
+		result, err := parse.ParseDuration(context.Background(), value)
+		if expectError {
+			Expect(err).NotTo(BeNil())
+			Expect(result).To(Equal(time.Duration(0)))
+		} else {
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(expectedResult))
+		}
+	},
+	Entry("time.Duration", 90*time.Minute, 90*time.Minute, false),
+	Entry("named time.Duration", TimeoutDuration(5*time.Second), 5*time.Second, false),
+	Entry(
+		"pointer to named time.Duration",
+		func() *TimeoutDuration { t := TimeoutDuration(time.Minute); return &t }(),
+		time.Minute,
+		false,
+	),
+	Entry("named int", Retries(3), 3*time.Second, false),
+	Entry("named int64", Count(30), 30*time.Second, false),
+	Entry("go duration", "1h30m", 90*time.Minute, false),
+	Entry("go duration with fraction", "1.5h", 90*time.Minute, false),
+	Entry("micro seconds", "10µs", 10*time.Microsecond, false),
+	Entry("days", "3d", 72*time.Hour, false),
+	Entry("weeks", "2w", 14*24*time.Hour, false),
+	Entry("days and hours", "1d12h", 36*time.Hour, false),
+	Entry("fraction of day", "0.5d", 12*time.Hour, false),
+	Entry("negative", "-1d2h", -26*time.Hour, false),
+	Entry("whitespace", " 2w ", 14*24*time.Hour, false),
+	Entry("int", 30, 30*time.Second, false),
+	Entry("int64", int64(30), 30*time.Second, false),
+	Entry("uint8", uint8(30), 30*time.Second, false),
+	Entry("float64", 1.5, 1500*time.Millisecond, false),
+	Entry("json.Number", json.Number("30"), 30*time.Second, false),
+	Entry("json.Number float", json.Number("0.25"), 250*time.Millisecond, false),
+	Entry("numeric string", "30", 30*time.Second, false),
+	Entry("zero string", "0", time.Duration(0), false),
+	Entry("pointer", func() *string { s := "1d"; return &s }(), 24*time.Hour, false),
+	Entry("invalid", "soon", time.Duration(0), true),
+	Entry("unknown unit", "3y", time.Duration(0), true),
+	Entry("missing unit", "1d12", time.Duration(0), true),
+	Entry("double sign", "--1d", time.Duration(0), true),
+	Entry("empty string", "", time.Duration(0), true),
+	Entry("nil", nil, time.Duration(0), true),
+	Entry("bool", true, time.Duration(0), true),
+)
+
+var _ = Describe("ParseDuration", func() {
+	It("uses the unit of the context for bare numbers", func() {
+		ctx := parse.WithDurationUnit(context.Background(), time.Millisecond)
+		result, err := parse.ParseDuration(ctx, 1500)
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal(1500 * time.Millisecond))
+		result, err = parse.ParseDuration(ctx, "2.5")
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal(2500 * time.Microsecond))
+		result, err = parse.ParseDuration(ctx, "1d")
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal(24 * time.Hour))
+		result, err = parse.ParseDuration(ctx, Count(30))
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal(30 * time.Millisecond))
+		result, err = parse.ParseDuration(ctx, TimeoutDuration(30))
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal(30 * time.Nanosecond))
+	})
+	DescribeTable("returns ErrOutOfRange",
+		func(value interface{}) {
+			_, err := parse.ParseDuration(context.Background(), value)
+			Expect(err).NotTo(BeNil())
+			Expect(stderrors.Is(err, parse.ErrOutOfRange)).To(BeTrue())
+		},
+		Entry("int64 seconds", int64(math.MaxInt64/1000)),
+		Entry("weeks", "100000w"),
+		Entry("sum", "15000w15000w"),
+		Entry("infinite", math.Inf(1)),
+		Entry("uint64", uint64(math.MaxUint64)),
+	)
+	It("is used by Parse", func() {
+		result, err := parse.Parse[[]time.Duration](
+			context.Background(),
+			[]interface{}{"1d", 30},
+		)
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal([]time.Duration{24 * time.Hour, 30 * time.Second}))
+	})
+})
+
+var _ = DescribeTable("ParseDurationDefault",
+	func(value interface{}, expectedResult time.Duration) {
+		result := parse.ParseDurationDefault(context.Background(), value, time.Minute)
+		Expect(result).To(Equal(expectedResult))
+	},
+	Entry("valid", "2w", 14*24*time.Hour),
+	Entry("invalid", "soon", time.Minute),
+	Entry("nil", nil, time.Minute),
+)
+
+var _ = DescribeTable("ParseDurationArray",
+	func(value interface{}, expectedResult []time.Duration, expectError bool) {
+		result, err := parse.ParseDurationArray(context.Background(), value)
+		if expectError {
+			Expect(err).NotTo(BeNil())
+			Expect(result).To(BeNil())
+		} else {
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(expectedResult))
+		}
+	},
+	Entry(
+		"[]string",
+		[]string{"1h", "1d", "1w"},
+		[]time.Duration{time.Hour, 24 * time.Hour, 7 * 24 * time.Hour},
+		false,
+	),
+	Entry("[]int", []int{1, 2}, []time.Duration{time.Second, 2 * time.Second}, false),
+	Entry(
+		"[]interface{}",
+		[]interface{}{time.Minute, "1d12h", 1.5},
+		[]time.Duration{time.Minute, 36 * time.Hour, 1500 * time.Millisecond},
+		false,
+	),
+	Entry("invalid element", []string{"1h", "soon"}, nil, true),
+	Entry("nil", nil, nil, true),
+)
+
+var _ = DescribeTable("ParseDurationArrayDefault",
+	func(value interface{}, expectedResult []time.Duration) {
+		result := parse.ParseDurationArrayDefault(
+			context.Background(),
+			value,
+			[]time.Duration{time.Minute},
+		)
+		Expect(result).To(Equal(expectedResult))
+	},
+	Entry("valid", []string{"1h"}, []time.Duration{time.Hour}),
+	Entry("invalid", []string{"soon"}, []time.Duration{time.Minute}),
+	Entry("nil", nil, []time.Duration{time.Minute}),
+)
@@ -0,0 +1,241 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse
+
+import (
+	"context"
+	"encoding"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/bborbe/errors"
+)
+
+// Encode converts a struct or a pointer to a struct into a map[string]interface{}.
+// It is the reverse of Decode and honors the same `parse` tags:
+// fields are stored under their tag name, `parse:"-"` fields are skipped and
+// `parse:"name,omitempty"` fields are skipped if they hold the zero value.
+//
+// Values are converted so that Decode and the typed parsers can read them back:
+//   - time.Time is formatted with the layout of the tag or WithTimeLayout, by default
+//     time.RFC3339Nano which Decode reads back without losing sub-second precision
+//   - time.Duration is formatted like "1h30m0s"
+//   - encoding.TextMarshaler values like netip.Addr or *big.Int become strings via ParseString
+//   - named basic types like `type Port int` become their builtin type, e.g. int
+//   - nested structs become maps, embedded structs without tag name are flattened and their
+//     fields are hidden by fields with the same name in the outer struct
+//   - slices and arrays become []interface{}, maps become map[string]interface{}
+//   - nil pointers and absent Optional values become nil
+//
+// Returns a *ParseError with the path of the failing field for values that cannot be encoded,
+// e.g. channels or functions.
+func Encode(ctx context.Context, value interface{}) (map[string]interface{}, error) {
+	v := reflect.ValueOf(value)
+	for v.Kind() == reflect.Pointer && !v.IsNil() {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil, newParseError(
+			value,
+			"map[string]interface{}",
+			errors.Wrapf(ctx, ErrInvalidType, "value must be a struct or a pointer to a struct"),
+		)
+	}
+	result := make(map[string]interface{})
+	if err := encodeStruct(ctx, v, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// encodedField is a field collected by encodeFields at the embedding depth it was found.
+type encodedField struct {
+	value   interface{}
+	depth   int
+	omitted bool
+}
+
+// encodeStruct stores the fields of value in result.
+// Like Go's field promotion, a field of an embedded struct is hidden by a field with the same name
+// at a shallower depth. At the same depth the first field wins.
+func encodeStruct(ctx context.Context, value reflect.Value, result map[string]interface{}) error {
+	fields := make(map[string]encodedField)
+	if err := encodeFields(ctx, value, 0, fields); err != nil {
+		return err
+	}
+	for name, field := range fields {
+		if !field.omitted {
+			result[name] = field.value
+		}
+	}
+	return nil
+}
+
+// encodeFields collects the fields of value and its embedded structs in fields.
+func encodeFields(
+	ctx context.Context,
+	value reflect.Value,
+	depth int,
+	fields map[string]encodedField,
+) error {
+	valueType := value.Type()
+	for i := 0; i < valueType.NumField(); i++ {
+		field := valueType.Field(i)
+		tag := parseFieldTag(field)
+		if tag.skip {
+			continue
+		}
+		fieldValue := value.Field(i)
+		if field.Anonymous && !hasTagName(field) {
+			if embedded, ok := encodeEmbeddedStruct(fieldValue); ok {
+				if err := encodeFields(ctx, embedded, depth+1, fields); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if existing, ok := fields[tag.name]; ok && existing.depth <= depth {
+			continue
+		}
+		if tag.omitEmpty && fieldValue.IsZero() {
+			fields[tag.name] = encodedField{depth: depth, omitted: true}
+			continue
+		}
+		fieldCtx := ctx
+		if tag.layout != "" {
+			fieldCtx = WithTimeLayout(ctx, tag.layout)
+		}
+		encoded, err := encodeValue(fieldCtx, fieldValue)
+		if err != nil {
+			return withPathPrefix(err, tag.name)
+		}
+		fields[tag.name] = encodedField{value: encoded, depth: depth}
+	}
+	return nil
+}
+
+// encodeEmbeddedStruct returns the struct of an embedded field. Nil pointers are skipped.
+func encodeEmbeddedStruct(value reflect.Value) (reflect.Value, bool) {
+	switch {
+	case value.Kind() == reflect.Struct:
+		return value, true
+	case value.Kind() == reflect.Pointer && value.Type().Elem().Kind() == reflect.Struct:
+		if value.IsNil() {
+			return reflect.Value{}, false
+		}
+		return value.Elem(), true
+	default:
+		return reflect.Value{}, false
+	}
+}
+
+// encodeValue converts value into its loosely-typed representation.
+func encodeValue(ctx context.Context, value reflect.Value) (interface{}, error) {
+	if !value.IsValid() {
+		return nil, nil
+	}
+	if (value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface) && value.IsNil() {
+		return nil, nil
+	}
+	if value.CanInterface() {
+		switch v := value.Interface().(type) {
+		case optional:
+			return encodeValue(ctx, reflect.ValueOf(v.optionalValue()))
+		case time.Time:
+			return v.Format(encodeTimeLayout(ctx)), nil
+		case time.Duration:
+			return v.String(), nil
+		case encoding.TextMarshaler:
+			return ParseString(ctx, v)
+		}
+	}
+	switch value.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		return encodeValue(ctx, value.Elem())
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return value.Convert(basicTypes[value.Kind()]).Interface(), nil
+	case reflect.Struct:
+		result := make(map[string]interface{})
+		if err := encodeStruct(ctx, value, result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case reflect.Slice, reflect.Array:
+		if value.Kind() == reflect.Slice && value.IsNil() {
+			return nil, nil
+		}
+		result := make([]interface{}, value.Len())
+		for i := 0; i < value.Len(); i++ {
+			encoded, err := encodeValue(ctx, value.Index(i))
+			if err != nil {
+				return nil, withPathPrefix(err, indexPath(i))
+			}
+			result[i] = encoded
+		}
+		return result, nil
+	case reflect.Map:
+		return encodeMap(ctx, value)
+	default:
+		return nil, newParseError(
+			value.Interface(),
+			"interface{}",
+			errors.Wrapf(ctx, ErrInvalidType, "cannot encode %v", value.Type()),
+		)
+	}
+}
+
+// encodeTimeLayout returns the time layout stored in ctx or time.RFC3339Nano.
+func encodeTimeLayout(ctx context.Context) string {
+	if layout, ok := ctx.Value(timeLayoutContextKey{}).(string); ok {
+		return layout
+	}
+	return time.RFC3339Nano
+}
+
+// encodeMap converts a map into a map[string]interface{} using ParseString for the keys.
+func encodeMap(ctx context.Context, value reflect.Value) (interface{}, error) {
+	if value.IsNil() {
+		return nil, nil
+	}
+	result := make(map[string]interface{}, value.Len())
+	iter := value.MapRange()
+	for iter.Next() {
+		key, err := ParseString(ctx, iter.Key().Interface())
+		if err != nil {
+			return nil, withPathPrefix(err, fmt.Sprint(iter.Key().Interface()))
+		}
+		encoded, err := encodeValue(ctx, iter.Value())
+		if err != nil {
+			return nil, withPathPrefix(err, key)
+		}
+		result[key] = encoded
+	}
+	return result, nil
+}
+
+// basicTypes maps basic kinds to their builtin type.
+var basicTypes = map[reflect.Kind]reflect.Type{
+	reflect.Bool:    reflect.TypeOf(false),
+	reflect.String:  reflect.TypeOf(""),
+	reflect.Int:     reflect.TypeOf(int(0)),
+	reflect.Int8:    reflect.TypeOf(int8(0)),
+	reflect.Int16:   reflect.TypeOf(int16(0)),
+	reflect.Int32:   reflect.TypeOf(int32(0)),
+	reflect.Int64:   reflect.TypeOf(int64(0)),
+	reflect.Uint:    reflect.TypeOf(uint(0)),
+	reflect.Uint8:   reflect.TypeOf(uint8(0)),
+	reflect.Uint16:  reflect.TypeOf(uint16(0)),
+	reflect.Uint32:  reflect.TypeOf(uint32(0)),
+	reflect.Uint64:  reflect.TypeOf(uint64(0)),
+	reflect.Float32: reflect.TypeOf(float32(0)),
+	reflect.Float64: reflect.TypeOf(float64(0)),
+}
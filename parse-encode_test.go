@@ -0,0 +1,183 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse_test
+
+import (
+	"context"
+	stderrors "errors"
+	"net/netip"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/parse"
+)
+
+type EncodeConfig struct {
+	DecodeBase
+	Port    Port                  `parse:"port"`
+	Host    string                `parse:"host"`
+	Start   time.Time             `parse:"start,layout=2006-01-02"`
+	Updated time.Time             `parse:"updated"`
+	Addr    netip.Addr            `parse:"addr"`
+	Color   Color                 `parse:"color"`
+	Timeout *int                  `parse:"timeout"`
+	Wait    time.Duration         `parse:"wait"`
+	Tags    []string              `parse:"tags"`
+	Items   []DecodeItem          `parse:"items"`
+	Labels  map[string]int        `parse:"labels"`
+	Limit   parse.Optional[int64] `parse:"limit"`
+	Note    string                `parse:"note,omitempty"`
+	Ignored string                `parse:"-"`
+}
+
+var _ = Describe("Encode", func() {
+	var ctx context.Context
+	var config EncodeConfig
+	BeforeEach(func() {
+		ctx = context.Background()
+		config = EncodeConfig{
+			DecodeBase: DecodeBase{ID: 7},
+			Port:       8080,
+			Host:       "localhost",
+			Start:      time.Date(2023, 12, 25, 0, 0, 0, 0, time.UTC),
+			Updated:    time.Date(2023, 12, 25, 10, 30, 0, 0, time.UTC),
+			Addr:       netip.MustParseAddr("10.0.0.1"),
+			Color:      ColorBlue,
+			Wait:       90 * time.Minute,
+			Tags:       []string{"a", "b"},
+			Items:      []DecodeItem{{Name: "apple", Price: 1.5}},
+			Labels:     map[string]int{"env": 1},
+			Ignored:    "ignored",
+		}
+	})
+	It("encodes all fields", func() {
+		result, err := parse.Encode(ctx, &config)
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal(map[string]interface{}{
+			"id":      int64(7),
+			"port":    8080,
+			"host":    "localhost",
+			"start":   "2023-12-25",
+			"updated": "2023-12-25T10:30:00Z",
+			"addr":    "10.0.0.1",
+			"color":   "blue",
+			"timeout": nil,
+			"wait":    "1h30m0s",
+			"tags":    []interface{}{"a", "b"},
+			"items": []interface{}{
+				map[string]interface{}{"name": "apple", "price": 1.5},
+			},
+			"labels": map[string]interface{}{"env": 1},
+			"limit":  nil,
+		}))
+	})
+	It("encodes present optional and omitempty fields", func() {
+		config.Limit = parse.NewOptional(int64(5))
+		config.Note = "hello"
+		result, err := parse.Encode(ctx, config)
+		Expect(err).To(BeNil())
+		Expect(result["limit"]).To(Equal(int64(5)))
+		Expect(result["note"]).To(Equal("hello"))
+	})
+	It("round-trips with Decode", func() {
+		timeout := 30
+		config.Timeout = &timeout
+		config.Ignored = ""
+		encoded, err := parse.Encode(ctx, config)
+		Expect(err).To(BeNil())
+		var decoded EncodeConfig
+		Expect(parse.Decode(ctx, encoded, &decoded)).To(BeNil())
+		Expect(decoded).To(Equal(config))
+	})
+	It("round-trips times with sub-second precision", func() {
+		config.Updated = time.Date(2024, 1, 1, 0, 0, 0, 123, time.UTC)
+		encoded, err := parse.Encode(ctx, config)
+		Expect(err).To(BeNil())
+		Expect(encoded["updated"]).To(Equal("2024-01-01T00:00:00.000000123Z"))
+		var decoded EncodeConfig
+		Expect(parse.Decode(ctx, encoded, &decoded)).To(BeNil())
+		Expect(decoded.Updated.Equal(config.Updated)).To(BeTrue())
+	})
+	It("round-trips all basic kinds with Decode", func() {
+		type target struct {
+			Bool    bool    `parse:"bool"`
+			String  string  `parse:"string"`
+			Int     int     `parse:"int"`
+			Int8    int8    `parse:"int8"`
+			Int16   int16   `parse:"int16"`
+			Int32   int32   `parse:"int32"`
+			Int64   int64   `parse:"int64"`
+			Uint    uint    `parse:"uint"`
+			Uint8   uint8   `parse:"uint8"`
+			Uint16  uint16  `parse:"uint16"`
+			Uint32  uint32  `parse:"uint32"`
+			Uint64  uint64  `parse:"uint64"`
+			Float32 float32 `parse:"float32"`
+			Float64 float64 `parse:"float64"`
+		}
+		value := target{
+			Bool:    true,
+			String:  "s",
+			Int:     -1,
+			Int8:    -8,
+			Int16:   -16,
+			Int32:   -32,
+			Int64:   -64,
+			Uint:    1,
+			Uint8:   8,
+			Uint16:  16,
+			Uint32:  32,
+			Uint64:  64,
+			Float32: 0.5,
+			Float64: 1.5,
+		}
+		encoded, err := parse.Encode(ctx, value)
+		Expect(err).To(BeNil())
+		var decoded target
+		Expect(parse.Decode(ctx, encoded, &decoded)).To(BeNil())
+		Expect(decoded).To(Equal(value))
+	})
+	It("prefers shallower fields over promoted fields of embedded structs", func() {
+		type Deep struct {
+			Name string
+			Kind string
+		}
+		type Inner struct {
+			Deep
+			Name string
+		}
+		type Other struct {
+			Kind string
+		}
+		type Outer struct {
+			Inner
+			Other
+			Name string
+		}
+		result, err := parse.Encode(ctx, Outer{
+			Inner: Inner{Deep: Deep{Name: "deep", Kind: "deep"}, Name: "inner"},
+			Other: Other{Kind: "other"},
+			Name:  "outer",
+		})
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal(map[string]interface{}{"Name": "outer", "Kind": "other"}))
+	})
+	It("returns error for non struct", func() {
+		_, err := parse.Encode(ctx, 42)
+		Expect(stderrors.Is(err, parse.ErrInvalidType)).To(BeTrue())
+	})
+	It("returns error with path for unsupported field", func() {
+		type target struct {
+			Items []interface{} `parse:"items"`
+		}
+		_, err := parse.Encode(ctx, target{Items: []interface{}{1, make(chan int)}})
+		Expect(stderrors.Is(err, parse.ErrInvalidType)).To(BeTrue())
+		var parseErr *parse.ParseError
+		Expect(stderrors.As(err, &parseErr)).To(BeTrue())
+		Expect(parseErr.Path).To(Equal("items[1]"))
+	})
+})
@@ -0,0 +1,136 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse
+
+import (
+	stderrors "errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bborbe/errors"
+)
+
+// ErrOutOfRange is matched by errors.Is for values that are out of range for the requested type.
+var ErrOutOfRange = stderrors.New("out of range")
+
+// ParseError is returned by all parsers if a value cannot be converted to the requested type.
+// errors.Is(err, ErrOutOfRange) reports whether the value was out of range for the target type,
+// errors.Is(err, ErrRequired) reports a missing required field in Decode,
+// errors.Is(err, ErrInvalidType) matches all other failures.
+type ParseError struct {
+	// Value is the source value that could not be converted.
+	Value interface{}
+	// SourceType is the type of Value, e.g. "string".
+	SourceType string
+	// TargetType is the requested type, e.g. "int64".
+	TargetType string
+	// Path locates Value inside slices, maps and structs, e.g. "[3]" or "items[3].price".
+	// Path is empty if the top-level value could not be converted.
+	Path string
+	// Cause is the underlying error.
+	Cause error
+}
+
+func (e *ParseError) Error() string {
+	if e.Path != "" {
+		return fmt.Sprintf(
+			"parse %s: %v (%s) as %s failed: %v",
+			e.Path,
+			e.Value,
+			e.SourceType,
+			e.TargetType,
+			e.Cause,
+		)
+	}
+	return fmt.Sprintf(
+		"parse %v (%s) as %s failed: %v",
+		e.Value,
+		e.SourceType,
+		e.TargetType,
+		e.Cause,
+	)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Cause
+}
+
+// Is reports ErrInvalidType for all failures that are not out of range or missing required fields.
+func (e *ParseError) Is(target error) bool {
+	return target == ErrInvalidType &&
+		!errors.Is(e.Cause, ErrOutOfRange) &&
+		!errors.Is(e.Cause, ErrRequired)
+}
+
+// newParseError returns a *ParseError for value and targetType.
+// A *ParseError of a nested parser is returned unchanged if it locates an element via Path,
+// otherwise only its cause is taken over.
+func newParseError(value interface{}, targetType string, cause error) *ParseError {
+	if parseError, ok := cause.(*ParseError); ok {
+		if parseError.Path != "" {
+			return parseError
+		}
+		cause = parseError.Cause
+	}
+	return &ParseError{
+		Value:      value,
+		SourceType: fmt.Sprintf("%T", value),
+		TargetType: targetType,
+		Cause:      cause,
+	}
+}
+
+// withPathPrefix prepends prefix to the Path of the *ParseError in err,
+// or of all *ParseError values if err is ParseErrors.
+func withPathPrefix(err error, prefix string) error {
+	if parseErrors, ok := err.(ParseErrors); ok {
+		for _, parseError := range parseErrors {
+			parseError.Path = joinPath(prefix, parseError.Path)
+		}
+		return err
+	}
+	var parseError *ParseError
+	if errors.As(err, &parseError) {
+		parseError.Path = joinPath(prefix, parseError.Path)
+	}
+	return err
+}
+
+// joinPath joins a path prefix like "items" or "[3]" with path like "[2]" or "price".
+func joinPath(prefix string, path string) string {
+	if path == "" {
+		return prefix
+	}
+	if prefix == "" || strings.HasPrefix(path, "[") {
+		return prefix + path
+	}
+	return prefix + "." + path
+}
+
+// OverflowError is returned when a numeric value cannot be represented exactly by the target type.
+// This covers values beyond the range of the target type, negative values for unsigned targets
+// and float values that are NaN or infinite.
+// errors.Is(err, ErrOutOfRange) is true for an *OverflowError.
+type OverflowError struct {
+	// Value is the source value that could not be converted.
+	Value interface{}
+	// TargetType is the name of the requested type, e.g. "int64".
+	TargetType string
+}
+
+func (e *OverflowError) Error() string {
+	return fmt.Sprintf("value %v (%T) out of range for %s", e.Value, e.Value, e.TargetType)
+}
+
+// Is reports ErrOutOfRange.
+func (e *OverflowError) Is(target error) bool {
+	return target == ErrOutOfRange
+}
+
+// indexPath returns the path of the element at index i, e.g. "[3]".
+func indexPath(i int) string {
+	return "[" + strconv.Itoa(i) + "]"
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/parse"
+)
+
+var _ = Describe("ParseError", func() {
+	var ctx context.Context
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+	It("contains path, value, source type, target type and cause in message", func() {
+		err := &parse.ParseError{
+			Value:      "banana",
+			SourceType: "string",
+			TargetType: "int",
+			Path:       "items[3].price",
+			Cause:      errors.New("boom"),
+		}
+		Expect(err.Error()).To(Equal("parse items[3].price: banana (string) as int failed: boom"))
+	})
+	It("contains value, source type, target type and cause in message without path", func() {
+		err := &parse.ParseError{
+			Value:      "banana",
+			SourceType: "string",
+			TargetType: "int",
+			Cause:      errors.New("boom"),
+		}
+		Expect(err.Error()).To(Equal("parse banana (string) as int failed: boom"))
+	})
+	It("describes the failed conversion", func() {
+		_, err := parse.ParseInt(ctx, "banana")
+		var parseError *parse.ParseError
+		Expect(errors.As(err, &parseError)).To(BeTrue())
+		Expect(parseError.Value).To(Equal("banana"))
+		Expect(parseError.SourceType).To(Equal("string"))
+		Expect(parseError.TargetType).To(Equal("int"))
+		Expect(parseError.Path).To(Equal(""))
+		Expect(parseError.Cause).NotTo(BeNil())
+	})
+	It("contains the index of the failing element", func() {
+		_, err := parse.ParseIntArray(ctx, []interface{}{1, 2, "banana"})
+		var parseError *parse.ParseError
+		Expect(errors.As(err, &parseError)).To(BeTrue())
+		Expect(parseError.Value).To(Equal("banana"))
+		Expect(parseError.TargetType).To(Equal("int"))
+		Expect(parseError.Path).To(Equal("[2]"))
+	})
+	It("contains the index of the failing string element", func() {
+		_, err := parse.ParseStrings(ctx, []interface{}{"a", struct{}{}})
+		var parseError *parse.ParseError
+		Expect(errors.As(err, &parseError)).To(BeTrue())
+		Expect(parseError.TargetType).To(Equal("string"))
+		Expect(parseError.Path).To(Equal("[1]"))
+	})
+	DescribeTable("matches ErrInvalidType",
+		func(fn func() error) {
+			err := fn()
+			Expect(errors.Is(err, parse.ErrInvalidType)).To(BeTrue())
+			Expect(errors.Is(err, parse.ErrOutOfRange)).To(BeFalse())
+			var parseError *parse.ParseError
+			Expect(errors.As(err, &parseError)).To(BeTrue())
+		},
+		Entry("ParseInt", func() error { _, err := parse.ParseInt(ctx, "banana"); return err }),
+		Entry("ParseInt64", func() error { _, err := parse.ParseInt64(ctx, "banana"); return err }),
+		Entry("ParseUint", func() error { _, err := parse.ParseUint(ctx, "banana"); return err }),
+		Entry("ParseUint32", func() error { _, err := parse.ParseUint32(ctx, "banana"); return err }),
+		Entry("ParseUint64", func() error { _, err := parse.ParseUint64(ctx, "banana"); return err }),
+		Entry("ParseFloat64", func() error { _, err := parse.ParseFloat64(ctx, "banana"); return err }),
+		Entry("ParseBool", func() error { _, err := parse.ParseBool(ctx, "banana"); return err }),
+		Entry("ParseString", func() error { _, err := parse.ParseString(ctx, struct{}{}); return err }),
+		Entry("ParseASCII", func() error { _, err := parse.ParseASCII(ctx, struct{}{}); return err }),
+		Entry("ParseStrings", func() error { _, err := parse.ParseStrings(ctx, 42); return err }),
+		Entry("ParseIntArray", func() error { _, err := parse.ParseIntArray(ctx, 42); return err }),
+		Entry("ParseInt64Array element", func() error {
+			_, err := parse.ParseInt64Array(ctx, []string{"banana"})
+			return err
+		}),
+		Entry("ParseUintArray", func() error { _, err := parse.ParseUintArray(ctx, 42); return err }),
+		Entry("ParseTime", func() error {
+			_, err := parse.ParseTime(ctx, "banana", time.RFC3339)
+			return err
+		}),
+		Entry("Parse", func() error { _, err := parse.Parse[complex64](ctx, 1); return err }),
+	)
+	DescribeTable("matches ErrOutOfRange",
+		func(fn func() error) {
+			err := fn()
+			Expect(errors.Is(err, parse.ErrOutOfRange)).To(BeTrue())
+			Expect(errors.Is(err, parse.ErrInvalidType)).To(BeFalse())
+			var overflowError *parse.OverflowError
+			Expect(errors.As(err, &overflowError)).To(BeTrue())
+		},
+		Entry("ParseInt", func() error { _, err := parse.ParseInt(ctx, 1e30); return err }),
+		Entry("ParseInt64", func() error { _, err := parse.ParseInt64(ctx, "1e30"); return err }),
+		Entry("ParseUint", func() error { _, err := parse.ParseUint(ctx, -1); return err }),
+		Entry("ParseUint32", func() error { _, err := parse.ParseUint32(ctx, 1e10); return err }),
+		Entry("ParseUint64", func() error { _, err := parse.ParseUint64(ctx, -1); return err }),
+		Entry("ParseFloat64", func() error { _, err := parse.ParseFloat64(ctx, "1e400"); return err }),
+		Entry("ParseIntArray element", func() error {
+			_, err := parse.ParseIntArray(ctx, []float64{1, 1e30})
+			return err
+		}),
+	)
+})
+
+var _ = Describe("OverflowError", func() {
+	It("contains value, source type and target type in message", func() {
+		err := &parse.OverflowError{Value: 1e19, TargetType: "int64"}
+		Expect(err.Error()).To(Equal("value 1e+19 (float64) out of range for int64"))
+	})
+	It("matches ErrOutOfRange", func() {
+		err := &parse.OverflowError{Value: 1e19, TargetType: "int64"}
+		Expect(errors.Is(err, parse.ErrOutOfRange)).To(BeTrue())
+	})
+})
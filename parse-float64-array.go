@@ -0,0 +1,28 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse
+
+import (
+	"context"
+)
+
+// ParseFloat64Array converts an interface{} value to a float64 slice.
+// Accepts any slice or array, e.g. []interface{}, []string, []int or []json.Number.
+// Each element is converted using ParseFloat64, see ParseSlice.
+// Returns a *ParseError if the value cannot be converted to []float64.
+func ParseFloat64Array(ctx context.Context, value interface{}) ([]float64, error) {
+	return ParseSlice[float64](ctx, value)
+}
+
+// ParseFloat64ArrayDefault converts an interface{} value to a float64 slice,
+// returning defaultValue on error.
+// This is a convenience wrapper around ParseFloat64Array that never returns an error.
+func ParseFloat64ArrayDefault(
+	ctx context.Context,
+	value interface{},
+	defaultValue []float64,
+) []float64 {
+	return ParseSliceDefault(ctx, value, defaultValue)
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse_test
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/parse"
+)
+
+var _ = DescribeTable("ParseFloat64Array",
+	func(input interface{}, expectedResult []float64, hasError bool) {
+		result, err := parse.ParseFloat64Array(context.Background(), input)
+		Expect(result).To(Equal(expectedResult))
+		if hasError {
+			Expect(err).NotTo(BeNil())
+		} else {
+			Expect(err).To(BeNil())
+		}
+	},
+	Entry("[]float64", []float64{1.5, 2}, []float64{1.5, 2}, false),
+	Entry("[]interface", []interface{}{1, "2.5"}, []float64{1, 2.5}, false),
+	Entry("[]json.Number", []json.Number{"1.25"}, []float64{1.25}, false),
+	Entry("[]float32", []float32{0.5}, []float64{0.5}, false),
+	Entry("invalid element", []string{"x"}, nil, true),
+	Entry("nil", nil, nil, true),
+)
+
+var _ = DescribeTable("ParseFloat64ArrayDefault",
+	func(input interface{}, defaultValue []float64, expectedResult []float64) {
+		result := parse.ParseFloat64ArrayDefault(context.Background(), input, defaultValue)
+		Expect(result).To(Equal(expectedResult))
+	},
+	Entry("valid", []string{"1.5"}, []float64{9}, []float64{1.5}),
+	Entry("invalid", "x", []float64{9}, []float64{9}),
+)
@@ -6,15 +6,32 @@ package parse
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strconv"
+
+	"github.com/bborbe/errors"
 )
 
 // ParseFloat64 converts an interface{} value to a float64.
-// Supported types: int, int32, int64, float32, float64, string, fmt.Stringer.
+// Supported types: int, int32, int64, uint, uint32, uint64, float32, float64, string,
+// json.Number, fmt.Stringer and named types of them.
 // String values are parsed using strconv.ParseFloat.
-// Returns an error if the value cannot be converted to float64.
+// Other types are formatted with fmt.Sprintf and parsed, unless strict mode is enabled.
+// Returns a *ParseError if the value cannot be converted to float64.
 func ParseFloat64(ctx context.Context, value interface{}) (float64, error) {
+	result, err := parseFloat64(ctx, value)
+	if err != nil {
+		return 0, newParseError(value, "float64", err)
+	}
+	return result, nil
+}
+
+func parseFloat64(ctx context.Context, value interface{}) (float64, error) {
+	value = indirect(value)
+	if value == nil {
+		return 0, nilValueError(ctx)
+	}
 	switch v := value.(type) {
 	case int:
 		return float64(v), nil
@@ -22,22 +39,46 @@ func ParseFloat64(ctx context.Context, value interface{}) (float64, error) {
 		return float64(v), nil
 	case int64:
 		return float64(v), nil
+	case uint:
+		return float64(v), nil
+	case uint32:
+		return float64(v), nil
+	case uint64:
+		return float64(v), nil
 	case float32:
 		return float64(v), nil
 	case float64:
 		return v, nil
 	case string:
-		return strconv.ParseFloat(v, 64)
+		result, err := strconv.ParseFloat(v, 64)
+		if errors.Is(err, strconv.ErrRange) {
+			return 0, &OverflowError{Value: v, TargetType: "float64"}
+		}
+		if err != nil {
+			return 0, err
+		}
+		return result, nil
+	case json.Number:
+		return parseFloat64(ctx, string(v))
 	case fmt.Stringer:
-		return ParseFloat64(ctx, v.String())
+		return parseFloat64(ctx, v.String())
 	default:
-		return ParseFloat64(ctx, fmt.Sprintf("%v", value))
+		if basic, ok := toBasicKind(value); ok {
+			return parseFloat64(ctx, basic)
+		}
+		if IsStrict(ctx) {
+			return 0, errUnsupportedType(ctx, value)
+		}
+		return parseFloat64(ctx, fmt.Sprintf("%v", value))
 	}
 }
 
 // ParseFloat64Default converts an interface{} value to a float64, returning defaultValue on error.
 // This is a convenience wrapper around ParseFloat64 that never returns an error.
 func ParseFloat64Default(ctx context.Context, value interface{}, defaultValue float64) float64 {
+	if isNilDefault(ctx, value) {
+		return defaultValue
+	}
 	result, err := ParseFloat64(ctx, value)
 	if err != nil {
 		return defaultValue
@@ -6,38 +6,22 @@ package parse
 
 import (
 	"context"
-
-	"github.com/bborbe/errors"
 )
 
 // ParseIntArray converts an interface{} value to an int slice.
-// Supported types: []int, []interface{}, []int32, []int64, []float32, []float64, []string.
-// Each element is converted using ParseInt.
-// Returns an error if the value cannot be converted to []int.
+// Accepts any slice or array, e.g. []interface{}, []string, []float64 or []MyInt.
+// Each element is converted using ParseInt, see ParseSlice.
+// Returns a *ParseError if the value cannot be converted to []int.
 func ParseIntArray(ctx context.Context, value interface{}) ([]int, error) {
-	switch v := value.(type) {
-	case []int:
-		return v, nil
-	case []interface{}:
-		return ParseIntArrayFromInterfaces(ctx, v)
-	case []int32:
-		return ParseIntArrayFromInterfaces(ctx, ToInterfaceList(v))
-	case []int64:
-		return ParseIntArrayFromInterfaces(ctx, ToInterfaceList(v))
-	case []float32:
-		return ParseIntArrayFromInterfaces(ctx, ToInterfaceList(v))
-	case []float64:
-		return ParseIntArrayFromInterfaces(ctx, ToInterfaceList(v))
-	case []string:
-		return ParseIntArrayFromInterfaces(ctx, ToInterfaceList(v))
-	default:
-		return nil, errors.Errorf(ctx, "invalid type %T", v)
-	}
+	return ParseSlice[int](ctx, value)
 }
 
 // ParseIntArrayDefault converts an interface{} value to an int slice, returning defaultValue on error.
 // This is a convenience wrapper around ParseIntArray that never returns an error.
 func ParseIntArrayDefault(ctx context.Context, value interface{}, defaultValue []int) []int {
+	if isNilDefault(ctx, value) {
+		return defaultValue
+	}
 	result, err := ParseIntArray(ctx, value)
 	if err != nil {
 		return defaultValue
@@ -47,17 +31,10 @@ func ParseIntArrayDefault(ctx context.Context, value interface{}, defaultValue [
 
 // ParseIntArrayFromInterfaces converts a slice of interface{} values to an int slice.
 // Each element is converted using ParseInt.
-// Returns an error if any element cannot be converted to int.
+// Returns a *ParseError with the index of the element in Path if any element cannot be
+// converted to int, or ParseErrors with all failing elements if WithCollectErrors is set.
 func ParseIntArrayFromInterfaces(ctx context.Context, values []interface{}) ([]int, error) {
-	result := make([]int, len(values))
-	for i, vv := range values {
-		pi, err := ParseInt(ctx, vv)
-		if err != nil {
-			return nil, errors.Wrapf(ctx, err, "parse int failed")
-		}
-		result[i] = pi
-	}
-	return result, nil
+	return ParseSlice[int](ctx, values)
 }
 
 // ToInterfaceList converts a typed slice to a slice of interface{}.
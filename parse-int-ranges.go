@@ -0,0 +1,245 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/bborbe/errors"
+)
+
+// DefaultMaxRangeExpansion is the maximum number of values ParseIntRanges expands to
+// if no limit is configured with WithMaxRangeExpansion.
+const DefaultMaxRangeExpansion = 10000
+
+type maxRangeExpansionContextKey struct{}
+
+// WithMaxRangeExpansion returns a copy of ctx that limits the number of values ParseIntRanges
+// expands to. A limit <= 0 keeps DefaultMaxRangeExpansion, use math.MaxInt for no limit.
+func WithMaxRangeExpansion(ctx context.Context, limit int) context.Context {
+	return context.WithValue(ctx, maxRangeExpansionContextKey{}, limit)
+}
+
+// MaxRangeExpansionFromContext returns the limit stored in ctx or DefaultMaxRangeExpansion.
+// A limit <= 0 also results in DefaultMaxRangeExpansion.
+func MaxRangeExpansionFromContext(ctx context.Context) int {
+	if limit, ok := ctx.Value(maxRangeExpansionContextKey{}).(int); ok && limit > 0 {
+		return limit
+	}
+	return DefaultMaxRangeExpansion
+}
+
+// IntRange is an inclusive range of integers.
+type IntRange struct {
+	From int
+	To   int
+}
+
+// String returns "From-To", or "From" for a single value.
+func (r IntRange) String() string {
+	if r.From == r.To {
+		return strconv.Itoa(r.From)
+	}
+	return strconv.Itoa(r.From) + "-" + strconv.Itoa(r.To)
+}
+
+// Contains reports whether value is within the range.
+func (r IntRange) Contains(value int) bool {
+	return r.From <= value && value <= r.To
+}
+
+// IntRanges is a sorted list of non-overlapping, non-adjacent ranges.
+type IntRanges []IntRange
+
+// String returns the compact notation, e.g. "1-5,8,10-12".
+func (r IntRanges) String() string {
+	parts := make([]string, len(r))
+	for i, intRange := range r {
+		parts[i] = intRange.String()
+	}
+	return strings.Join(parts, ",")
+}
+
+// Contains reports whether value is within one of the ranges.
+func (r IntRanges) Contains(value int) bool {
+	i := sort.Search(len(r), func(i int) bool {
+		return r[i].To >= value
+	})
+	return i < len(r) && r[i].Contains(value)
+}
+
+// Len returns the number of values in all ranges.
+func (r IntRanges) Len() int {
+	var result int
+	for _, intRange := range r {
+		result += intRange.To - intRange.From + 1
+	}
+	return result
+}
+
+// ParseIntRanges converts an interface{} value in range notation to the expanded values and
+// their compact ranges.
+// Supported types: strings like "1-5,8,10..12", where ranges are written as "From-To" or
+// "From..To" and separated by commas, and slices whose elements are such ranges or integers.
+// Bounds in strings must be integers, negative bounds are supported, e.g. "-5--1", while
+// "1.5" or "1e3" are rejected. Other elements are converted using ParseInt with RoundReject.
+// Ranges are sorted and merged, so the expanded values are sorted and unique.
+// Returns a *ParseError with the index of the failing range in Path for invalid ranges and
+// a *ParseError matching ErrOutOfRange if the expansion exceeds MaxRangeExpansionFromContext.
+func ParseIntRanges(ctx context.Context, value interface{}) ([]int, IntRanges, error) {
+	ranges, err := parseIntRanges(ctx, value)
+	if err != nil {
+		return nil, nil, err
+	}
+	limit := MaxRangeExpansionFromContext(ctx)
+	var count uint64
+	for _, intRange := range ranges {
+		count += uint64(intRange.To) - uint64(intRange.From) + 1
+		if count > uint64(limit) || count == 0 {
+			return nil, nil, newParseError(
+				value,
+				"[]int",
+				errors.Wrapf(ctx, ErrOutOfRange, "expansion exceeds %d values", limit),
+			)
+		}
+	}
+	result := make([]int, 0, count)
+	for _, intRange := range ranges {
+		for i := intRange.From; ; i++ {
+			result = append(result, i)
+			if i == intRange.To {
+				break
+			}
+		}
+	}
+	return result, ranges, nil
+}
+
+// ParseIntRangesDefault converts an interface{} value in range notation to the expanded values,
+// returning defaultValue on error.
+// This is a convenience wrapper around ParseIntRanges that never returns an error.
+func ParseIntRangesDefault(ctx context.Context, value interface{}, defaultValue []int) []int {
+	if isNilDefault(ctx, value) {
+		return defaultValue
+	}
+	result, _, err := ParseIntRanges(ctx, value)
+	if err != nil {
+		return defaultValue
+	}
+	return result
+}
+
+func parseIntRanges(ctx context.Context, value interface{}) (IntRanges, error) {
+	v := indirect(value)
+	if v == nil {
+		return nil, nilParseError(ctx, "[]int")
+	}
+	if reflect.ValueOf(v).Kind() == reflect.String {
+		parts, err := Split(ctx, reflect.ValueOf(v).String(), SplitOptions{
+			Trim:  true,
+			Empty: EmptySkip,
+		})
+		if err != nil {
+			return nil, newParseError(value, "[]int", err)
+		}
+		v = parts
+	}
+	elements, err := toInterfaceSlice(ctx, v, "[]int")
+	if err != nil {
+		return nil, err
+	}
+	ranges := make(IntRanges, 0, len(elements))
+	errs := newErrorCollector(ctx)
+	for i, element := range elements {
+		intRange, err := parseIntRange(ctx, element)
+		if err != nil {
+			if !errs.add(err, indexPath(i)) {
+				break
+			}
+			continue
+		}
+		ranges = append(ranges, intRange)
+	}
+	if err := errs.err(); err != nil {
+		return nil, err
+	}
+	return mergeIntRanges(ranges), nil
+}
+
+// parseIntRange converts a single value like 8, "8", "1-5" or "10..12" to an IntRange.
+// Bounds must be integral, "1.5" or "1e3" are rejected.
+func parseIntRange(ctx context.Context, value interface{}) (IntRange, error) {
+	str, ok := indirect(value).(string)
+	if !ok {
+		v, err := ParseInt(WithRoundingMode(ctx, RoundReject), value)
+		if err != nil {
+			return IntRange{}, err
+		}
+		return IntRange{From: v, To: v}, nil
+	}
+	str = strings.TrimSpace(str)
+	from, to, ok := strings.Cut(str, "..")
+	if !ok && len(str) > 1 {
+		if i := strings.Index(str[1:], "-"); i >= 0 {
+			from, to, ok = str[:i+1], str[i+2:], true
+		}
+	}
+	if !ok {
+		v, err := parseIntBound(ctx, str)
+		if err != nil {
+			return IntRange{}, newParseError(value, "IntRange", err)
+		}
+		return IntRange{From: v, To: v}, nil
+	}
+	fromValue, err := parseIntBound(ctx, from)
+	if err != nil {
+		return IntRange{}, newParseError(value, "IntRange", err)
+	}
+	toValue, err := parseIntBound(ctx, to)
+	if err != nil {
+		return IntRange{}, newParseError(value, "IntRange", err)
+	}
+	if fromValue > toValue {
+		return IntRange{}, newParseError(
+			value,
+			"IntRange",
+			errors.Wrapf(ctx, ErrInvalidType, "range start %d is after end %d", fromValue, toValue),
+		)
+	}
+	return IntRange{From: fromValue, To: toValue}, nil
+}
+
+// parseIntBound converts a range bound like "-5" with ParseInt, rejecting fractions and
+// exponents.
+func parseIntBound(ctx context.Context, value string) (int, error) {
+	value = strings.TrimSpace(value)
+	if strings.ContainsAny(value, "eE") {
+		return 0, errors.Wrapf(ctx, ErrInvalidType, "invalid bound '%s'", value)
+	}
+	return ParseInt(WithRoundingMode(ctx, RoundReject), value)
+}
+
+// mergeIntRanges sorts ranges and merges overlapping and adjacent ones.
+func mergeIntRanges(ranges IntRanges) IntRanges {
+	sort.Slice(ranges, func(i, j int) bool {
+		return ranges[i].From < ranges[j].From
+	})
+	result := make(IntRanges, 0, len(ranges))
+	for _, intRange := range ranges {
+		last := len(result) - 1
+		if last >= 0 && (intRange.From <= result[last].To || intRange.From-1 == result[last].To) {
+			if intRange.To > result[last].To {
+				result[last].To = intRange.To
+			}
+			continue
+		}
+		result = append(result, intRange)
+	}
+	return result
+}
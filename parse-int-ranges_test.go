@@ -0,0 +1,129 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse_test
+
+import (
+	"context"
+	stderrors "errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/parse"
+)
+
+var _ = DescribeTable("ParseIntRanges",
+	func(value interface{}, expectedResult []int, expectedRanges string, expectError bool) {
+		result, ranges, err := parse.ParseIntRanges(context.Background(), value)
+		if expectError {
+			Expect(err).NotTo(BeNil())
+			Expect(result).To(BeNil())
+			Expect(ranges).To(BeNil())
+		} else {
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(expectedResult))
+			Expect(ranges.String()).To(Equal(expectedRanges))
+		}
+	},
+	Entry("single", "8", []int{8}, "8", false),
+	Entry("dash range", "1-3", []int{1, 2, 3}, "1-3", false),
+	Entry("dot range", "10..12", []int{10, 11, 12}, "10-12", false),
+	Entry(
+		"mixed",
+		"1-5,8,10..12",
+		[]int{1, 2, 3, 4, 5, 8, 10, 11, 12},
+		"1-5,8,10-12",
+		false,
+	),
+	Entry("whitespace", " 1 - 2 , 4 ", []int{1, 2, 4}, "1-2,4", false),
+	Entry("unsorted and overlapping", "5,1-3,2-4", []int{1, 2, 3, 4, 5}, "1-5", false),
+	Entry("negative", "-3--1,0", []int{-3, -2, -1, 0}, "-3-0", false),
+	Entry("negative dot range", "-2..1", []int{-2, -1, 0, 1}, "-2-1", false),
+	Entry("empty string", "", []int{}, "", false),
+	Entry("slice", []interface{}{1, "3-4", 6}, []int{1, 3, 4, 6}, "1,3-4,6", false),
+	Entry("int slice", []int{2, 1}, []int{1, 2}, "1-2", false),
+	Entry("reversed", "5-1", nil, "", true),
+	Entry("invalid bound", "1-x", nil, "", true),
+	Entry("missing bound", "1-", nil, "", true),
+	Entry("invalid", "x", nil, "", true),
+	Entry("nil", nil, nil, "", true),
+	Entry("too large", "1-100000", nil, "", true),
+	Entry("fractional single", "1.5,3", nil, "", true),
+	Entry("fractional bound", "1-2.5", nil, "", true),
+	Entry("exponent", "1e3", nil, "", true),
+	Entry("exponent bound", "1..1e1", nil, "", true),
+	Entry("integral decimal bound", "1-2.0", []int{1, 2}, "1-2", false),
+	Entry("fractional float element", []interface{}{1.5}, nil, "", true),
+	Entry("integral float element", []interface{}{2.0}, []int{2}, "2", false),
+)
+
+var _ = Describe("ParseIntRanges", func() {
+	It("returns path of invalid range", func() {
+		_, _, err := parse.ParseIntRanges(context.Background(), "1,2-x")
+		var parseErr *parse.ParseError
+		Expect(stderrors.As(err, &parseErr)).To(BeTrue())
+		Expect(parseErr.Path).To(Equal("[1]"))
+		Expect(parseErr.Value).To(Equal("2-x"))
+	})
+	It("returns ErrOutOfRange if expansion exceeds limit", func() {
+		ctx := parse.WithMaxRangeExpansion(context.Background(), 3)
+		_, _, err := parse.ParseIntRanges(ctx, "1-2,5-6")
+		Expect(stderrors.Is(err, parse.ErrOutOfRange)).To(BeTrue())
+	})
+	It("allows expansion up to limit", func() {
+		ctx := parse.WithMaxRangeExpansion(context.Background(), 4)
+		result, _, err := parse.ParseIntRanges(ctx, "1-2,5-6")
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal([]int{1, 2, 5, 6}))
+	})
+	It("returns default limit", func() {
+		Expect(parse.MaxRangeExpansionFromContext(context.Background())).
+			To(Equal(parse.DefaultMaxRangeExpansion))
+	})
+	It("returns default limit for limit <= 0", func() {
+		for _, limit := range []int{0, -1} {
+			ctx := parse.WithMaxRangeExpansion(context.Background(), limit)
+			Expect(parse.MaxRangeExpansionFromContext(ctx)).
+				To(Equal(parse.DefaultMaxRangeExpansion))
+			_, _, err := parse.ParseIntRanges(ctx, "1-100000")
+			Expect(stderrors.Is(err, parse.ErrOutOfRange)).To(BeTrue())
+		}
+	})
+	It("returns ErrOutOfRange for bounds beyond int", func() {
+		_, _, err := parse.ParseIntRanges(context.Background(), "1-99999999999999999999")
+		Expect(stderrors.Is(err, parse.ErrOutOfRange)).To(BeTrue())
+	})
+})
+
+var _ = Describe("IntRanges", func() {
+	var ranges parse.IntRanges
+	BeforeEach(func() {
+		ranges = parse.IntRanges{{From: 1, To: 5}, {From: 8, To: 8}, {From: 10, To: 12}}
+	})
+	It("returns Len", func() {
+		Expect(ranges.Len()).To(Equal(9))
+	})
+	DescribeTable("Contains",
+		func(value int, expected bool) {
+			Expect(ranges.Contains(value)).To(Equal(expected))
+		},
+		Entry("before", 0, false),
+		Entry("start", 1, true),
+		Entry("inside", 3, true),
+		Entry("gap", 6, false),
+		Entry("single", 8, true),
+		Entry("end", 12, true),
+		Entry("after", 13, false),
+	)
+})
+
+var _ = DescribeTable("ParseIntRangesDefault",
+	func(value interface{}, defaultValue []int, expectedResult []int) {
+		result := parse.ParseIntRangesDefault(context.Background(), value, defaultValue)
+		Expect(result).To(Equal(expectedResult))
+	},
+	Entry("valid", "1-2", []int{9}, []int{1, 2}),
+	Entry("invalid", "x", []int{9}, []int{9}),
+)
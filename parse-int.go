@@ -6,44 +6,80 @@ package parse
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
-	"strconv"
 
-	"github.com/bborbe/math"
+	"github.com/bborbe/errors"
 )
 
 // ParseInt converts an interface{} value to an int.
-// Supported types: int, int32, int64, float32, float64, string, fmt.Stringer.
-// Float values are rounded to the nearest integer.
-// String values are parsed using strconv.Atoi.
-// Returns an error if the value cannot be converted to int.
+// Supported types: int, int32, int64, uint, uint32, uint64, float32, float64, string, json.Number,
+// fmt.Stringer and named types of them.
+// Float values and decimal strings are rounded according to the RoundingMode of ctx,
+// by default to the nearest integer.
+// Integer strings and json.Number are parsed exactly using strconv.ParseInt.
+// Other types are formatted with fmt.Sprintf and parsed, unless strict mode is enabled.
+// Returns a *ParseError wrapping an *OverflowError if the value is
+// out of range for int, NaN or infinite.
+// Returns a *ParseError if the value cannot be converted to int.
 func ParseInt(ctx context.Context, value interface{}) (int, error) {
+	result, err := parseInt(ctx, value)
+	if err != nil {
+		return 0, newParseError(value, "int", err)
+	}
+	return result, nil
+}
+
+func parseInt(ctx context.Context, value interface{}) (int, error) {
+	value = indirect(value)
+	if value == nil {
+		return 0, nilValueError(ctx)
+	}
 	switch v := value.(type) {
 	case int:
 		return v, nil
 	case int32:
 		return int(v), nil
-	case int64:
-		return int(v), nil
-	case float32:
-		return int(math.Round(float64(v))), nil
-	case float64:
-		return int(math.Round(v)), nil
-	case string:
-		return strconv.Atoi(v)
+	case int64, uint, uint32, uint64, float32, float64, string, json.Number:
+		result, err := parseInt64(ctx, v)
+		if err != nil {
+			if errors.Is(err, ErrOutOfRange) {
+				return 0, &OverflowError{Value: value, TargetType: "int"}
+			}
+			return 0, err
+		}
+		return int64ToInt(result, value)
 	case fmt.Stringer:
-		return strconv.Atoi(v.String())
+		return parseInt(ctx, v.String())
 	default:
-		return ParseInt(ctx, fmt.Sprintf("%v", value))
+		if basic, ok := toBasicKind(value); ok {
+			return parseInt(ctx, basic)
+		}
+		if IsStrict(ctx) {
+			return 0, errUnsupportedType(ctx, value)
+		}
+		return parseInt(ctx, fmt.Sprintf("%v", value))
 	}
 }
 
 // ParseIntDefault converts an interface{} value to an int, returning defaultValue on error.
 // This is a convenience wrapper around ParseInt that never returns an error.
 func ParseIntDefault(ctx context.Context, value interface{}, defaultValue int) int {
+	if isNilDefault(ctx, value) {
+		return defaultValue
+	}
 	result, err := ParseInt(ctx, value)
 	if err != nil {
 		return defaultValue
 	}
 	return result
 }
+
+// int64ToInt reports an *OverflowError for value if v does not fit into an int,
+// which can only happen on 32-bit platforms.
+func int64ToInt(v int64, value interface{}) (int, error) {
+	if int64(int(v)) != v {
+		return 0, &OverflowError{Value: value, TargetType: "int"}
+	}
+	return int(v), nil
+}
@@ -6,18 +6,37 @@ package parse
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"math"
 	"strconv"
 
-	"github.com/bborbe/math"
+	"github.com/bborbe/errors"
 )
 
 // ParseInt64 converts an interface{} value to an int64.
-// Supported types: int64, int32, int, float32, float64, string.
-// Float values are rounded to the nearest integer.
-// String values are parsed using strconv.ParseInt.
-// Returns an error if the value cannot be converted to int64.
+// Supported types: int64, int32, int, uint64, uint32, uint, float32, float64, string, json.Number,
+// fmt.Stringer and named types of them.
+// Float values and decimal strings are rounded according to the RoundingMode of ctx,
+// by default to the nearest integer.
+// Integer strings and json.Number are parsed exactly using strconv.ParseInt.
+// Other types are formatted with fmt.Sprintf and parsed, unless strict mode is enabled.
+// Returns a *ParseError wrapping an *OverflowError if the value is
+// out of range for int64, NaN or infinite.
+// Returns a *ParseError if the value cannot be converted to int64.
 func ParseInt64(ctx context.Context, value interface{}) (int64, error) {
+	result, err := parseInt64(ctx, value)
+	if err != nil {
+		return 0, newParseError(value, "int64", err)
+	}
+	return result, nil
+}
+
+func parseInt64(ctx context.Context, value interface{}) (int64, error) {
+	value = indirect(value)
+	if value == nil {
+		return 0, nilValueError(ctx)
+	}
 	switch v := value.(type) {
 	case int64:
 		return v, nil
@@ -25,23 +44,88 @@ func ParseInt64(ctx context.Context, value interface{}) (int64, error) {
 		return int64(v), nil
 	case int:
 		return int64(v), nil
+	case uint64:
+		return uint64ToInt64(v, value)
+	case uint32:
+		return int64(v), nil
+	case uint:
+		return uint64ToInt64(uint64(v), value)
 	case float32:
-		return int64(math.Round(float64(v))), nil
+		return float64ToInt64(ctx, float64(v), value)
 	case float64:
-		return int64(math.Round(v)), nil
+		return float64ToInt64(ctx, v, value)
 	case string:
-		return strconv.ParseInt(v, 10, 64)
+		return parseInt64String(ctx, v)
+	case json.Number:
+		return parseInt64String(ctx, string(v))
+	case fmt.Stringer:
+		return parseInt64(ctx, v.String())
 	default:
-		return ParseInt64(ctx, fmt.Sprintf("%v", value))
+		if basic, ok := toBasicKind(value); ok {
+			return parseInt64(ctx, basic)
+		}
+		if IsStrict(ctx) {
+			return 0, errUnsupportedType(ctx, value)
+		}
+		return parseInt64(ctx, fmt.Sprintf("%v", value))
 	}
 }
 
 // ParseInt64Default converts an interface{} value to an int64, returning defaultValue on error.
 // This is a convenience wrapper around ParseInt64 that never returns an error.
 func ParseInt64Default(ctx context.Context, value interface{}, defaultValue int64) int64 {
+	if isNilDefault(ctx, value) {
+		return defaultValue
+	}
 	result, err := ParseInt64(ctx, value)
 	if err != nil {
 		return defaultValue
 	}
 	return result
 }
+
+// parseInt64String parses v as integer, rounding decimal strings exactly and falling back to a
+// float for other strings like "Inf".
+func parseInt64String(ctx context.Context, v string) (int64, error) {
+	result, err := strconv.ParseInt(v, 10, 64)
+	if err == nil {
+		return result, nil
+	}
+	if errors.Is(err, strconv.ErrRange) {
+		return 0, &OverflowError{Value: v, TargetType: "int64"}
+	}
+	if i, ok, roundErr := roundDecimalString(ctx, v); ok {
+		if roundErr != nil {
+			return 0, roundErr
+		}
+		if !i.IsInt64() {
+			return 0, &OverflowError{Value: v, TargetType: "int64"}
+		}
+		return i.Int64(), nil
+	}
+	f, floatErr := strconv.ParseFloat(v, 64)
+	if floatErr != nil && !errors.Is(floatErr, strconv.ErrRange) {
+		return 0, err
+	}
+	return float64ToInt64(ctx, f, v)
+}
+
+// float64ToInt64 rounds v according to the RoundingMode of ctx and reports an *OverflowError
+// for value if the result does not fit into an int64.
+func float64ToInt64(ctx context.Context, v float64, value interface{}) (int64, error) {
+	rounded, err := roundFloat64(ctx, v)
+	if err != nil {
+		return 0, err
+	}
+	if math.IsNaN(rounded) || rounded < -(1<<63) || rounded >= 1<<63 {
+		return 0, &OverflowError{Value: value, TargetType: "int64"}
+	}
+	return int64(rounded), nil
+}
+
+func uint64ToInt64(v uint64, value interface{}) (int64, error) {
+	if v > math.MaxInt64 {
+		return 0, &OverflowError{Value: value, TargetType: "int64"}
+	}
+	return int64(v), nil
+}
@@ -6,6 +6,9 @@ package parse_test
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"math"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -32,6 +35,15 @@ var _ = DescribeTable("ParseInt64",
 	Entry("int64", 1337, int64(1337), false),
 	Entry("float32", float32(1337), int64(1337), false),
 	Entry("int64", 1337, int64(1337), false),
+	Entry("uint64", uint64(1337), int64(1337), false),
+	Entry("min float64", float64(-(1<<63)), int64(math.MinInt64), false),
+	Entry("max string", "9223372036854775807", int64(math.MaxInt64), false),
+	Entry("decimal string beyond 2^53", "9007199254740993.0", int64(9007199254740993), false),
+	Entry("max decimal string", "9223372036854775807.4", int64(math.MaxInt64), false),
+	Entry("min decimal string", "-9223372036854775808.4", int64(math.MinInt64), false),
+	Entry("exponent string", "1.5e3", int64(1500), false),
+	Entry("exponent string beyond 2^53", "9.007199254740993e15", int64(9007199254740993), false),
+	Entry("invalid exponent string", "1e", int64(0), true),
 	Entry("invalid", "banana", int64(0), true),
 )
 
@@ -50,3 +62,26 @@ var _ = DescribeTable("ParseInt64Default",
 	Entry("nil returns default", nil, int64(123), int64(123)),
 	Entry("unsupported type returns default", []int{1, 2}, int64(888), int64(888)),
 )
+
+var _ = DescribeTable("ParseInt64 overflow",
+	func(value interface{}) {
+		result, err := parse.ParseInt64(context.Background(), value)
+		Expect(result).To(Equal(int64(0)))
+		var overflowError *parse.OverflowError
+		Expect(errors.As(err, &overflowError)).To(BeTrue())
+		Expect(fmt.Sprintf("%T %v", overflowError.Value, overflowError.Value)).
+			To(Equal(fmt.Sprintf("%T %v", value, value)))
+		Expect(overflowError.TargetType).To(Equal("int64"))
+	},
+	Entry("float64 too large", 1e19),
+	Entry("float64 too small", -1e19),
+	Entry("float64 NaN", math.NaN()),
+	Entry("float64 +Inf", math.Inf(1)),
+	Entry("float64 -Inf", math.Inf(-1)),
+	Entry("float32 too large", float32(1e19)),
+	Entry("uint64 too large", uint64(math.MaxUint64)),
+	Entry("string too large", "9223372036854775808"),
+	Entry("decimal string too large", "9223372036854775807.5"),
+	Entry("exponent string too large", "1e19"),
+	Entry("huge exponent string", "1e1000000000"),
+)
@@ -6,6 +6,9 @@ package parse_test
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"math"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -32,9 +35,28 @@ var _ = DescribeTable("ParseInt",
 	Entry("int", 1337, 1337, false),
 	Entry("float32", float32(1337), 1337, false),
 	Entry("int", 1337, 1337, false),
+	Entry("uint32", uint32(1337), 1337, false),
+	Entry("float64", 1336.6, 1337, false),
 	Entry("invalid", "banana", 0, true),
 )
 
+var _ = DescribeTable("ParseInt overflow",
+	func(value interface{}) {
+		result, err := parse.ParseInt(context.Background(), value)
+		Expect(result).To(Equal(0))
+		var overflowError *parse.OverflowError
+		Expect(errors.As(err, &overflowError)).To(BeTrue())
+		Expect(fmt.Sprintf("%T %v", overflowError.Value, overflowError.Value)).
+			To(Equal(fmt.Sprintf("%T %v", value, value)))
+		Expect(overflowError.TargetType).To(Equal("int"))
+	},
+	Entry("float64 too large", 1e19),
+	Entry("float64 NaN", math.NaN()),
+	Entry("float64 Inf", math.Inf(1)),
+	Entry("uint64 too large", uint64(math.MaxUint64)),
+	Entry("string too large", "92233720368547758070"),
+)
+
 var _ = DescribeTable("ParseIntDefault",
 	func(value interface{}, defaultValue int, expectedResult int) {
 		result := parse.ParseIntDefault(context.Background(), value, defaultValue)
@@ -0,0 +1,68 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/bborbe/errors"
+)
+
+type jsonContextKey struct{}
+
+// WithJSON returns a copy of ctx that makes ParseStrings, ParseSlice, the array parsers,
+// ParseMap, Parse and Decode decode string input holding a JSON array or object,
+// e.g. "[1,2,3]" or `{"a":1}`, before converting the elements.
+// Numbers are decoded as json.Number, so integers are converted exactly.
+func WithJSON(ctx context.Context) context.Context {
+	return context.WithValue(ctx, jsonContextKey{}, true)
+}
+
+// IsJSON returns true if decoding of JSON text is enabled in ctx.
+func IsJSON(ctx context.Context) bool {
+	enabled, _ := ctx.Value(jsonContextKey{}).(bool)
+	return enabled
+}
+
+// decodeJSONValue decodes value if WithJSON is set and value is a string holding a JSON array
+// or object. All other values are returned unchanged.
+func decodeJSONValue(ctx context.Context, value interface{}) (interface{}, error) {
+	if !IsJSON(ctx) {
+		return value, nil
+	}
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.String {
+		return value, nil
+	}
+	text := strings.TrimSpace(v.String())
+	if !strings.HasPrefix(text, "[") && !strings.HasPrefix(text, "{") {
+		return value, nil
+	}
+	decoder := json.NewDecoder(bytes.NewBufferString(text))
+	decoder.UseNumber()
+	var result interface{}
+	if err := decoder.Decode(&result); err != nil {
+		return nil, errors.Wrapf(ctx, ErrInvalidType, "decode json failed: %v", err)
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, errors.Wrapf(ctx, ErrInvalidType, "decode json failed: unexpected trailing data")
+	}
+	return result, nil
+}
+
+// expandString converts string input of the slice parsers.
+// JSON text is decoded if WithJSON is set, otherwise strings are split if WithSplit is set.
+func expandString(ctx context.Context, value interface{}) (interface{}, error) {
+	decoded, err := decodeJSONValue(ctx, value)
+	if err != nil {
+		return nil, err
+	}
+	return splitValue(ctx, decoded)
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse_test
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/parse"
+)
+
+var _ = Describe("WithJSON", func() {
+	var ctx context.Context
+	BeforeEach(func() {
+		ctx = parse.WithJSON(context.Background())
+	})
+	It("is disabled by default", func() {
+		Expect(parse.IsJSON(context.Background())).To(BeFalse())
+		Expect(parse.IsJSON(ctx)).To(BeTrue())
+	})
+	It("decodes arrays for ParseIntArray", func() {
+		Expect(parse.ParseIntArray(ctx, " [1, 2, 3] ")).To(Equal([]int{1, 2, 3}))
+	})
+	It("decodes large integers exactly", func() {
+		Expect(parse.ParseInt64Array(ctx, "[9007199254740993]")).
+			To(Equal([]int64{9007199254740993}))
+	})
+	It("decodes arrays for ParseStrings", func() {
+		Expect(parse.ParseStrings(ctx, `["a", 1, true]`)).To(Equal([]string{"a", "1", "true"}))
+	})
+	It("keeps other strings for ParseStrings", func() {
+		Expect(parse.ParseStrings(ctx, "a,b")).To(Equal([]string{"a,b"}))
+	})
+	It("splits other strings if WithSplit is set", func() {
+		ctx = parse.WithSplit(ctx, parse.SplitOptions{})
+		Expect(parse.ParseIntArray(ctx, "1,2")).To(Equal([]int{1, 2}))
+		Expect(parse.ParseIntArray(ctx, "[1,2]")).To(Equal([]int{1, 2}))
+	})
+	It("does not decode without WithJSON", func() {
+		_, err := parse.ParseIntArray(context.Background(), "[1,2]")
+		Expect(err).NotTo(BeNil())
+		Expect(parse.ParseStrings(context.Background(), "[1,2]")).To(Equal([]string{"[1,2]"}))
+	})
+	It("returns error for invalid json", func() {
+		_, err := parse.ParseIntArray(ctx, "[1,2")
+		Expect(stderrors.Is(err, parse.ErrInvalidType)).To(BeTrue())
+	})
+	It("returns error for trailing data", func() {
+		_, err := parse.ParseIntArray(ctx, "[1,2]]")
+		Expect(stderrors.Is(err, parse.ErrInvalidType)).To(BeTrue())
+	})
+	It("returns path of invalid element", func() {
+		_, err := parse.ParseIntArray(ctx, `[1,"x"]`)
+		var parseErr *parse.ParseError
+		Expect(stderrors.As(err, &parseErr)).To(BeTrue())
+		Expect(parseErr.Path).To(Equal("[1]"))
+	})
+	It("decodes objects for Parse", func() {
+		Expect(parse.Parse[map[string]int](ctx, `{"a": 1, "b": "2"}`)).
+			To(Equal(map[string]int{"a": 1, "b": 2}))
+	})
+	It("decodes nested structs for Decode", func() {
+		var config DecodeConfig
+		err := parse.Decode(ctx, map[string]interface{}{
+			"host":  "localhost",
+			"owner": `{"name": "ben", "price": 1.5}`,
+			"items": `[{"name": "apple"}]`,
+		}, &config)
+		Expect(err).To(BeNil())
+		Expect(config.Owner).To(Equal(DecodeItem{Name: "ben", Price: 1.5}))
+		Expect(config.Items).To(Equal([]DecodeItem{{Name: "apple"}}))
+	})
+})
+
+var _ = DescribeTable("ParseMap[string,interface{}] with WithJSON",
+	func(
+		ctx context.Context,
+		value interface{},
+		expectedResult map[string]interface{},
+		expectError bool,
+	) {
+		result, err := parse.ParseMap[string, interface{}](ctx, value)
+		if expectError {
+			Expect(err).NotTo(BeNil())
+			Expect(result).To(BeNil())
+		} else {
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(expectedResult))
+		}
+	},
+	Entry(
+		"map[string]interface{}",
+		context.Background(),
+		map[string]interface{}{"a": 1},
+		map[string]interface{}{"a": 1},
+		false,
+	),
+	Entry(
+		"map[interface{}]interface{}",
+		context.Background(),
+		map[interface{}]interface{}{"a": 1, 2: "b"},
+		map[string]interface{}{"a": 1, "2": "b"},
+		false,
+	),
+	Entry(
+		"map[string]int",
+		context.Background(),
+		map[string]int{"a": 1},
+		map[string]interface{}{"a": 1},
+		false,
+	),
+	Entry(
+		"json object",
+		parse.WithJSON(context.Background()),
+		`{"a": 1, "b": [true]}`,
+		map[string]interface{}{"a": json.Number("1"), "b": []interface{}{true}},
+		false,
+	),
+	Entry("json without WithJSON", context.Background(), `{"a": 1}`, nil, true),
+	Entry("json array", parse.WithJSON(context.Background()), `[1]`, nil, true),
+	Entry("invalid json", parse.WithJSON(context.Background()), `{"a"`, nil, true),
+	Entry("int", context.Background(), 1, nil, true),
+	Entry("nil", context.Background(), nil, nil, true),
+)
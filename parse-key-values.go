@@ -0,0 +1,249 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/bborbe/errors"
+)
+
+// DuplicatePolicy defines how ParseKeyValues handles keys that occur more than once.
+type DuplicatePolicy int
+
+const (
+	// DuplicateLast keeps the last value of a duplicate key. This is the default.
+	DuplicateLast DuplicatePolicy = iota
+	// DuplicateFirst keeps the first value of a duplicate key.
+	DuplicateFirst
+	// DuplicateAsError returns an error wrapping ErrInvalidType for duplicate keys.
+	DuplicateAsError
+)
+
+// KeyValueOptions configure how ParseKeyValues, ParseMap and ParseStringMap parse key=value
+// strings like "env=prod,team=core".
+type KeyValueOptions struct {
+	// PairSeparator between pairs, "," if empty. Use " " for logfmt-style strings.
+	PairSeparator string
+	// KeyValueSeparator between key and value, "=" if empty.
+	KeyValueSeparator string
+	// Quotes allows keys and values enclosed in double quotes to contain separators and
+	// surrounding whitespace.
+	Quotes bool
+	// Escape makes a backslash include the following character literally, e.g. "a\,b".
+	Escape bool
+	// Duplicates defines how duplicate keys are handled.
+	Duplicates DuplicatePolicy
+}
+
+type keyValueOptionsContextKey struct{}
+
+// WithKeyValueOptions returns a copy of ctx that makes ParseKeyValues, ParseMap and
+// ParseStringMap parse key=value strings according to options.
+func WithKeyValueOptions(ctx context.Context, options KeyValueOptions) context.Context {
+	return context.WithValue(ctx, keyValueOptionsContextKey{}, options)
+}
+
+// KeyValueOptionsFromContext returns the KeyValueOptions stored in ctx or the defaults.
+func KeyValueOptionsFromContext(ctx context.Context) KeyValueOptions {
+	options, _ := ctx.Value(keyValueOptionsContextKey{}).(KeyValueOptions)
+	return options
+}
+
+// ParseKeyValues converts an interface{} value to a map[string]string.
+// Strings like "env=prod,team=core" are parsed according to the KeyValueOptions of
+// WithKeyValueOptions. Whitespace around unquoted keys and values is trimmed and empty pairs
+// are skipped.
+// Maps are converted with ParseStringMap, all other values are converted with ParseString first.
+// Use ParseMap for typed keys and values.
+// Returns a *ParseError with the index of the malformed pair in Path that names the pair,
+// e.g. for a missing separator, an empty key or a duplicate key with DuplicateAsError.
+func ParseKeyValues(ctx context.Context, value interface{}) (map[string]string, error) {
+	v := indirect(value)
+	if v == nil {
+		return nil, nilParseError(ctx, "map[string]string")
+	}
+	if reflect.ValueOf(v).Kind() == reflect.Map {
+		return ParseStringMap(ctx, v)
+	}
+	str, err := parseString(ctx, v)
+	if err != nil {
+		return nil, newParseError(value, "map[string]string", err)
+	}
+	result, err := parseKeyValues(ctx, str)
+	if err != nil {
+		return nil, newParseError(value, "map[string]string", err)
+	}
+	return result, nil
+}
+
+// ParseKeyValuesDefault converts an interface{} value to a map[string]string,
+// returning defaultValue on error.
+// This is a convenience wrapper around ParseKeyValues that never returns an error.
+func ParseKeyValuesDefault(
+	ctx context.Context,
+	value interface{},
+	defaultValue map[string]string,
+) map[string]string {
+	if isNilDefault(ctx, value) {
+		return defaultValue
+	}
+	result, err := ParseKeyValues(ctx, value)
+	if err != nil {
+		return defaultValue
+	}
+	return result
+}
+
+// parseKeyValues parses value according to the KeyValueOptions of ctx.
+func parseKeyValues(ctx context.Context, value string) (map[string]string, error) {
+	options := KeyValueOptionsFromContext(ctx)
+	pairs, err := splitKeyValuePairs(ctx, value, options)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]string, len(pairs))
+	for i, pair := range pairs {
+		if !pair.hasSeparator && pair.key == "" {
+			continue
+		}
+		if !pair.hasSeparator {
+			return nil, pairError(
+				ctx, pair, i,
+				"missing '%s' in pair '%s'", keyValueSeparator(options), pair.raw,
+			)
+		}
+		if pair.key == "" {
+			return nil, pairError(ctx, pair, i, "empty key in pair '%s'", pair.raw)
+		}
+		if _, ok := result[pair.key]; ok {
+			switch options.Duplicates {
+			case DuplicateFirst:
+				continue
+			case DuplicateAsError:
+				return nil, pairError(ctx, pair, i, "duplicate key in pair '%s'", pair.raw)
+			}
+		}
+		result[pair.key] = pair.value
+	}
+	return result, nil
+}
+
+// pairError returns a *ParseError with Path "[i]" for the malformed pair.
+func pairError(
+	ctx context.Context,
+	pair keyValuePair,
+	i int,
+	format string,
+	args ...interface{},
+) error {
+	err := newParseError(
+		pair.raw,
+		"map[string]string",
+		errors.Wrapf(ctx, ErrInvalidType, format, args...),
+	)
+	err.Path = indexPath(i)
+	return err
+}
+
+// keyValuePair is a pair scanned by splitKeyValuePairs.
+type keyValuePair struct {
+	raw          string
+	key          string
+	value        string
+	hasSeparator bool
+}
+
+// keyValueField collects a key or value while scanning.
+type keyValueField struct {
+	builder strings.Builder
+	quoted  bool
+	closed  bool
+}
+
+// String returns the field, trimmed if it was not quoted.
+func (f *keyValueField) String() string {
+	if f.quoted {
+		return f.builder.String()
+	}
+	return strings.TrimSpace(f.builder.String())
+}
+
+// splitKeyValuePairs scans value into pairs, honoring quotes and escapes.
+func splitKeyValuePairs(
+	ctx context.Context,
+	value string,
+	options KeyValueOptions,
+) ([]keyValuePair, error) {
+	pairSeparator := options.PairSeparator
+	if pairSeparator == "" {
+		pairSeparator = ","
+	}
+	separator := keyValueSeparator(options)
+	var result []keyValuePair
+	var key, val keyValueField
+	field := &key
+	hasSeparator := false
+	start := 0
+	inQuote := false
+	appendPair := func(end int) {
+		result = append(result, keyValuePair{
+			raw:          value[start:end],
+			key:          key.String(),
+			value:        val.String(),
+			hasSeparator: hasSeparator,
+		})
+		key, val = keyValueField{}, keyValueField{}
+		field = &key
+		hasSeparator = false
+	}
+	for i := 0; i < len(value); {
+		c := value[i]
+		switch {
+		case options.Escape && c == '\\' && i+1 < len(value):
+			field.builder.WriteByte(value[i+1])
+			i += 2
+		case options.Quotes && c == '"':
+			if !inQuote && !field.quoted && strings.TrimSpace(field.builder.String()) == "" {
+				field.builder.Reset()
+				field.quoted = true
+			}
+			if inQuote {
+				field.closed = true
+			}
+			inQuote = !inQuote
+			i++
+		case !inQuote && strings.HasPrefix(value[i:], pairSeparator):
+			appendPair(i)
+			i += len(pairSeparator)
+			start = i
+		case !inQuote && !hasSeparator && strings.HasPrefix(value[i:], separator):
+			hasSeparator = true
+			field = &val
+			i += len(separator)
+		case field.closed && unicode.IsSpace(rune(c)):
+			i++
+		default:
+			field.builder.WriteByte(c)
+			i++
+		}
+	}
+	if inQuote {
+		return nil, errors.Wrapf(ctx, ErrInvalidType, "unterminated quote in '%s'", value[start:])
+	}
+	appendPair(len(value))
+	return result, nil
+}
+
+// keyValueSeparator returns the configured KeyValueSeparator or "=".
+func keyValueSeparator(options KeyValueOptions) string {
+	if options.KeyValueSeparator == "" {
+		return "="
+	}
+	return options.KeyValueSeparator
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse_test
+
+import (
+	"context"
+	stderrors "errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/parse"
+)
+
+var _ = DescribeTable("ParseKeyValues",
+	func(
+		options parse.KeyValueOptions,
+		value interface{},
+		expectedResult map[string]string,
+		expectError bool,
+	) {
+		ctx := parse.WithKeyValueOptions(context.Background(), options)
+		result, err := parse.ParseKeyValues(ctx, value)
+		if expectError {
+			Expect(err).NotTo(BeNil())
+			Expect(result).To(BeNil())
+		} else {
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(expectedResult))
+		}
+	},
+	Entry(
+		"labels",
+		parse.KeyValueOptions{},
+		"env=prod,team=core",
+		map[string]string{"env": "prod", "team": "core"},
+		false,
+	),
+	Entry(
+		"whitespace and empty pairs",
+		parse.KeyValueOptions{},
+		" env = prod ,, team=core,",
+		map[string]string{"env": "prod", "team": "core"},
+		false,
+	),
+	Entry("empty string", parse.KeyValueOptions{}, "", map[string]string{}, false),
+	Entry(
+		"empty value",
+		parse.KeyValueOptions{},
+		"env=",
+		map[string]string{"env": ""},
+		false,
+	),
+	Entry(
+		"value containing separator",
+		parse.KeyValueOptions{},
+		"url=a=b",
+		map[string]string{"url": "a=b"},
+		false,
+	),
+	Entry(
+		"logfmt",
+		parse.KeyValueOptions{PairSeparator: " ", Quotes: true},
+		`level=info  msg="hello world" user=ben`,
+		map[string]string{"level": "info", "msg": "hello world", "user": "ben"},
+		false,
+	),
+	Entry(
+		"custom separators",
+		parse.KeyValueOptions{PairSeparator: ";", KeyValueSeparator: ":"},
+		"env:prod;team:core",
+		map[string]string{"env": "prod", "team": "core"},
+		false,
+	),
+	Entry(
+		"quoted separators and whitespace",
+		parse.KeyValueOptions{Quotes: true},
+		`"a,b"=" x=y ", c = "d"`,
+		map[string]string{"a,b": " x=y ", "c": "d"},
+		false,
+	),
+	Entry(
+		"escaped separators",
+		parse.KeyValueOptions{Escape: true},
+		`a\=b=c\,d,e=\\`,
+		map[string]string{"a=b": "c,d", "e": `\`},
+		false,
+	),
+	Entry(
+		"escaped quote",
+		parse.KeyValueOptions{Quotes: true, Escape: true},
+		`msg="say \"hi\""`,
+		map[string]string{"msg": `say "hi"`},
+		false,
+	),
+	Entry(
+		"duplicate last",
+		parse.KeyValueOptions{},
+		"a=1,a=2",
+		map[string]string{"a": "2"},
+		false,
+	),
+	Entry(
+		"duplicate first",
+		parse.KeyValueOptions{Duplicates: parse.DuplicateFirst},
+		"a=1,a=2",
+		map[string]string{"a": "1"},
+		false,
+	),
+	Entry(
+		"duplicate as error",
+		parse.KeyValueOptions{Duplicates: parse.DuplicateAsError},
+		"a=1,a=2",
+		nil,
+		true,
+	),
+	Entry(
+		"map",
+		parse.KeyValueOptions{},
+		map[string]interface{}{"a": 1},
+		map[string]string{"a": "1"},
+		false,
+	),
+	Entry("missing separator", parse.KeyValueOptions{}, "a=1,b", nil, true),
+	Entry("empty key", parse.KeyValueOptions{}, "=1", nil, true),
+	Entry("unterminated quote", parse.KeyValueOptions{Quotes: true}, `a="1`, nil, true),
+	Entry("nil", parse.KeyValueOptions{}, nil, nil, true),
+)
+
+var _ = Describe("ParseKeyValues", func() {
+	It("names the malformed pair", func() {
+		_, err := parse.ParseKeyValues(context.Background(), "env=prod, team ,tier=web")
+		Expect(err).NotTo(BeNil())
+		Expect(err.Error()).To(ContainSubstring("missing '=' in pair ' team '"))
+		Expect(stderrors.Is(err, parse.ErrInvalidType)).To(BeTrue())
+		var parseErr *parse.ParseError
+		Expect(stderrors.As(err, &parseErr)).To(BeTrue())
+		Expect(parseErr.Path).To(Equal("[1]"))
+		Expect(parseErr.Value).To(Equal(" team "))
+	})
+	It("names the duplicate pair", func() {
+		ctx := parse.WithKeyValueOptions(
+			context.Background(),
+			parse.KeyValueOptions{Duplicates: parse.DuplicateAsError},
+		)
+		_, err := parse.ParseKeyValues(ctx, "a=1,a=2")
+		Expect(err).NotTo(BeNil())
+		Expect(err.Error()).To(ContainSubstring("duplicate key in pair 'a=2'"))
+	})
+	It("is used by ParseMap for typed maps", func() {
+		ctx := parse.WithKeyValueOptions(
+			context.Background(),
+			parse.KeyValueOptions{PairSeparator: " ", KeyValueSeparator: ":"},
+		)
+		result, err := parse.ParseMap[string, int](ctx, "a:1 b:2")
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal(map[string]int{"a": 1, "b": 2}))
+	})
+})
+
+var _ = DescribeTable("ParseKeyValuesDefault",
+	func(value interface{}, defaultValue map[string]string, expectedResult map[string]string) {
+		result := parse.ParseKeyValuesDefault(context.Background(), value, defaultValue)
+		Expect(result).To(Equal(expectedResult))
+	},
+	Entry("valid", "a=1", map[string]string{"x": "y"}, map[string]string{"a": "1"}),
+	Entry("invalid", "a", map[string]string{"x": "y"}, map[string]string{"x": "y"}),
+	Entry("nil", nil, map[string]string{"x": "y"}, map[string]string{"x": "y"}),
+)
@@ -0,0 +1,128 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// ParseMap converts an interface{} value to a map[K]V.
+// Supported types: maps of any key and value type like map[string]interface{} from JSON or
+// map[interface{}]interface{} from YAML, strings like "k1=v1,k2=v2" parsed like ParseKeyValues
+// according to WithKeyValueOptions and strings holding a JSON object if WithJSON is set.
+// Keys and values are converted with the parsers matching K and V, see Parse.
+// Returns a *ParseError with the offending key in Path if a key or value cannot be converted,
+// or ParseErrors with all failing keys if WithCollectErrors is set.
+func ParseMap[K comparable, V any](ctx context.Context, value interface{}) (map[K]V, error) {
+	var result map[K]V
+	parsed, err := parseMapValue(ctx, value, reflect.TypeOf(result))
+	if err != nil {
+		return nil, err
+	}
+	reflect.ValueOf(&result).Elem().Set(parsed)
+	return result, nil
+}
+
+// ParseMapDefault converts an interface{} value to a map[K]V, returning defaultValue on error.
+// This is a convenience wrapper around ParseMap that never returns an error.
+func ParseMapDefault[K comparable, V any](
+	ctx context.Context,
+	value interface{},
+	defaultValue map[K]V,
+) map[K]V {
+	if isNilDefault(ctx, value) {
+		return defaultValue
+	}
+	result, err := ParseMap[K, V](ctx, value)
+	if err != nil {
+		return defaultValue
+	}
+	return result
+}
+
+// ParseStringMap converts an interface{} value to a map[string]string, see ParseMap.
+func ParseStringMap(ctx context.Context, value interface{}) (map[string]string, error) {
+	return ParseMap[string, string](ctx, value)
+}
+
+// ParseStringMapDefault converts an interface{} value to a map[string]string,
+// returning defaultValue on error.
+// This is a convenience wrapper around ParseStringMap that never returns an error.
+func ParseStringMapDefault(
+	ctx context.Context,
+	value interface{},
+	defaultValue map[string]string,
+) map[string]string {
+	return ParseMapDefault(ctx, value, defaultValue)
+}
+
+// parseMapValue converts a map value or a "k1=v1,k2=v2" string parsed like ParseKeyValues into
+// a map of targetType, converting keys and values with parseValue.
+// Keys are processed in sorted order so the reported error is deterministic.
+func parseMapValue(
+	ctx context.Context,
+	value interface{},
+	targetType reflect.Type,
+) (reflect.Value, error) {
+	v := indirect(value)
+	if v == nil {
+		if err := nilValueError(ctx); err != nil {
+			return reflect.Value{}, newParseError(value, targetType.String(), err)
+		}
+		return reflect.Zero(targetType), nil
+	}
+	if reflect.TypeOf(v) == targetType {
+		return reflect.ValueOf(v), nil
+	}
+	v, err := decodeJSONValue(ctx, v)
+	if err != nil {
+		return reflect.Value{}, newParseError(value, targetType.String(), err)
+	}
+	if reflect.ValueOf(v).Kind() == reflect.String {
+		v, err = parseKeyValues(ctx, reflect.ValueOf(v).String())
+		if err != nil {
+			return reflect.Value{}, newParseError(value, targetType.String(), err)
+		}
+	}
+	source := reflect.ValueOf(v)
+	if source.Kind() != reflect.Map {
+		return reflect.Value{}, newParseError(
+			value,
+			targetType.String(),
+			errUnsupportedType(ctx, v),
+		)
+	}
+	keys := source.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+	})
+	result := reflect.MakeMapWithSize(targetType, len(keys))
+	errs := newErrorCollector(ctx)
+	for _, key := range keys {
+		path := fmt.Sprint(key.Interface())
+		k, err := parseValue(ctx, key.Interface(), targetType.Key())
+		if err != nil {
+			if !errs.add(err, path) {
+				break
+			}
+			continue
+		}
+		e, err := parseValue(ctx, source.MapIndex(key).Interface(), targetType.Elem())
+		if err != nil {
+			if !errs.add(err, path) {
+				break
+			}
+			continue
+		}
+		result.SetMapIndex(k, e)
+	}
+	if err := errs.err(); err != nil {
+		return reflect.Value{}, err
+	}
+	return result, nil
+}
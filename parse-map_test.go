@@ -0,0 +1,124 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse_test
+
+import (
+	"context"
+	stderrors "errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/parse"
+)
+
+var _ = DescribeTable("ParseMap[string,int]",
+	func(value interface{}, expectedResult map[string]int, expectError bool) {
+		result, err := parse.ParseMap[string, int](context.Background(), value)
+		if expectError {
+			Expect(err).NotTo(BeNil())
+			Expect(result).To(BeNil())
+		} else {
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(expectedResult))
+		}
+	},
+	Entry("map[string]int", map[string]int{"a": 1}, map[string]int{"a": 1}, false),
+	Entry(
+		"map[string]interface{}",
+		map[string]interface{}{"a": "1", "b": 2.0},
+		map[string]int{"a": 1, "b": 2},
+		false,
+	),
+	Entry(
+		"map[interface{}]interface{}",
+		map[interface{}]interface{}{"a": 1, 2: "3"},
+		map[string]int{"a": 1, "2": 3},
+		false,
+	),
+	Entry("key value string", "a=1, b = 2", map[string]int{"a": 1, "b": 2}, false),
+	Entry("empty string", "", map[string]int{}, false),
+	Entry("missing =", "a=1,b", nil, true),
+	Entry("invalid value", "a=x", nil, true),
+	Entry("int", 1, nil, true),
+	Entry("nil", nil, nil, true),
+)
+
+var _ = Describe("ParseMap", func() {
+	It("converts keys", func() {
+		result, err := parse.ParseMap[int, Direction](
+			context.Background(),
+			map[string]interface{}{"1": "north", "2": "south"},
+		)
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal(map[int]Direction{1: "north", 2: "south"}))
+	})
+	It("returns offending key for invalid value", func() {
+		_, err := parse.ParseMap[string, int](
+			context.Background(),
+			map[string]interface{}{"a": 1, "b": "x"},
+		)
+		var parseErr *parse.ParseError
+		Expect(stderrors.As(err, &parseErr)).To(BeTrue())
+		Expect(parseErr.Path).To(Equal("b"))
+		Expect(parseErr.Value).To(Equal("x"))
+	})
+	It("returns offending key for invalid key", func() {
+		_, err := parse.ParseMap[int, string](context.Background(), "1=a,x=b")
+		var parseErr *parse.ParseError
+		Expect(stderrors.As(err, &parseErr)).To(BeTrue())
+		Expect(parseErr.Path).To(Equal("x"))
+	})
+	It("returns pair for invalid key value string", func() {
+		_, err := parse.ParseMap[string, string](context.Background(), "a=1,broken")
+		Expect(err).To(MatchError(ContainSubstring("'broken'")))
+	})
+	It("converts nested values", func() {
+		result, err := parse.ParseMap[string, []int](
+			context.Background(),
+			map[string]interface{}{"a": []interface{}{1, "2"}},
+		)
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal(map[string][]int{"a": {1, 2}}))
+	})
+})
+
+var _ = DescribeTable("ParseStringMap",
+	func(value interface{}, expectedResult map[string]string, expectError bool) {
+		result, err := parse.ParseStringMap(context.Background(), value)
+		if expectError {
+			Expect(err).NotTo(BeNil())
+		} else {
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(expectedResult))
+		}
+	},
+	Entry(
+		"map[string]interface{}",
+		map[string]interface{}{"a": 1, "b": true},
+		map[string]string{"a": "1", "b": "true"},
+		false,
+	),
+	Entry(
+		"map[interface{}]interface{}",
+		map[interface{}]interface{}{"a": "x"},
+		map[string]string{"a": "x"},
+		false,
+	),
+	Entry("key value string", "env=prod,team=core", map[string]string{
+		"env":  "prod",
+		"team": "core",
+	}, false),
+	Entry("invalid value", map[string]interface{}{"a": []int{1}}, nil, true),
+)
+
+var _ = DescribeTable("ParseStringMapDefault",
+	func(value interface{}, defaultValue map[string]string, expectedResult map[string]string) {
+		result := parse.ParseStringMapDefault(context.Background(), value, defaultValue)
+		Expect(result).To(Equal(expectedResult))
+	},
+	Entry("valid", "a=b", nil, map[string]string{"a": "b"}),
+	Entry("invalid", "a", map[string]string{"x": "y"}, map[string]string{"x": "y"}),
+)
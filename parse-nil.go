@@ -0,0 +1,138 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding"
+	stderrors "errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/bborbe/errors"
+)
+
+// ErrNilValue is wrapped by the error returned for nil values with NilAsError.
+var ErrNilValue = stderrors.New("nil value")
+
+// NilPolicy defines how the parsers handle nil values and nil pointers.
+type NilPolicy int
+
+const (
+	// NilAsError returns a *ParseError wrapping ErrNilValue for nil values.
+	// This is the default, except for ParseStrings which returns nil for backward compatibility
+	// if no NilPolicy is configured.
+	NilAsError NilPolicy = iota
+	// NilAsZero returns the zero value of the target type for nil values,
+	// also from the ParseXDefault variants.
+	NilAsZero
+	// NilAsDefault returns defaultValue from the ParseXDefault variants and the zero value of the
+	// target type from all other parsers for nil values.
+	NilAsDefault
+)
+
+type nilPolicyContextKey struct{}
+
+// WithNilPolicy returns a copy of ctx that makes all parsers handle nil values according to policy.
+func WithNilPolicy(ctx context.Context, policy NilPolicy) context.Context {
+	return context.WithValue(ctx, nilPolicyContextKey{}, policy)
+}
+
+// NilPolicyFromContext returns the NilPolicy stored in ctx or NilAsError.
+func NilPolicyFromContext(ctx context.Context) NilPolicy {
+	policy, _ := nilPolicyFromContext(ctx)
+	return policy
+}
+
+func nilPolicyFromContext(ctx context.Context) (NilPolicy, bool) {
+	policy, ok := ctx.Value(nilPolicyContextKey{}).(NilPolicy)
+	return policy, ok
+}
+
+// nilValueError returns the error for a nil value according to the NilPolicy of ctx,
+// or nil if the zero value should be returned.
+func nilValueError(ctx context.Context) error {
+	if NilPolicyFromContext(ctx) == NilAsError {
+		return errors.Wrapf(ctx, ErrNilValue, "value is nil")
+	}
+	return nil
+}
+
+// isNilDefault reports whether a ParseXDefault variant should return its defaultValue
+// because value is nil and the NilPolicy of ctx is NilAsDefault.
+func isNilDefault(ctx context.Context, value interface{}) bool {
+	return NilPolicyFromContext(ctx) == NilAsDefault && indirect(value) == nil
+}
+
+// indirect dereferences pointers, including nested ones, and returns nil for nil pointers.
+// Pointers are kept if they implement fmt.Stringer, encoding.TextMarshaler or HasStrings but the
+// value they point to does not, e.g. *big.Int.
+// Optional values and the database/sql Null types like sql.NullInt64 or sql.Null[T] are
+// unwrapped, absent values return nil. Other driver.Valuer implementations are kept, so enums
+// with a String method are still formatted with it.
+func indirect(value interface{}) interface{} {
+	v := reflect.ValueOf(value)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil
+		}
+		if hasPointerOnlyTextMethods(v.Type()) {
+			break
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return nil
+	}
+	switch result := v.Interface().(type) {
+	case optional:
+		return indirect(result.optionalValue())
+	case driver.Valuer:
+		if !isSQLNullType(v.Type()) {
+			return result
+		}
+		if driverValue, err := result.Value(); err == nil {
+			return indirect(driverValue)
+		}
+		return result
+	default:
+		return result
+	}
+}
+
+// isSQLNullType reports whether t is one of the database/sql Null types, e.g. sql.NullInt64 or
+// sql.Null[T].
+func isSQLNullType(t reflect.Type) bool {
+	return t.PkgPath() == "database/sql" && strings.HasPrefix(t.Name(), "Null")
+}
+
+var (
+	stringerType        = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	hasStringsType      = reflect.TypeOf((*HasStrings)(nil)).Elem()
+)
+
+// hasPointerOnlyTextMethods reports whether pointer type t implements fmt.Stringer,
+// encoding.TextMarshaler or HasStrings while its element type does not.
+func hasPointerOnlyTextMethods(t reflect.Type) bool {
+	for _, iface := range []reflect.Type{stringerType, textMarshalerType, hasStringsType} {
+		if t.Implements(iface) && !t.Elem().Implements(iface) {
+			return true
+		}
+	}
+	return false
+}
+
+// nilParseError returns a *ParseError for a nil value of targetType according to the NilPolicy
+// of ctx, or nil if the zero value should be returned.
+func nilParseError(ctx context.Context, targetType string) error {
+	if err := nilValueError(ctx); err != nil {
+		return newParseError(nil, targetType, err)
+	}
+	return nil
+}
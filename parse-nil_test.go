@@ -0,0 +1,143 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/parse"
+)
+
+var _ = Describe("Pointer", func() {
+	var ctx context.Context
+	BeforeEach(func() {
+		ctx = parse.WithStrict(context.Background())
+	})
+	It("dereferences *int", func() {
+		value := 42
+		Expect(parse.ParseInt(ctx, &value)).To(Equal(42))
+	})
+	It("dereferences nested pointers", func() {
+		value := int64(42)
+		pointer := &value
+		Expect(parse.ParseInt64(ctx, &pointer)).To(Equal(int64(42)))
+	})
+	It("dereferences *string", func() {
+		value := "1.5"
+		Expect(parse.ParseFloat64(ctx, &value)).To(Equal(1.5))
+		Expect(parse.ParseString(ctx, &value)).To(Equal("1.5"))
+	})
+	It("dereferences *bool", func() {
+		value := true
+		Expect(parse.ParseBool(ctx, &value)).To(BeTrue())
+	})
+	It("dereferences *uint64", func() {
+		value := uint64(7)
+		Expect(parse.ParseUint32(ctx, &value)).To(Equal(uint32(7)))
+	})
+	It("dereferences *time.Time", func() {
+		value := time.Date(2023, 12, 25, 10, 30, 0, 0, time.UTC)
+		Expect(parse.ParseTime(ctx, &value, time.RFC3339)).To(Equal(value))
+	})
+	It("dereferences *[]int", func() {
+		value := []int{1, 2}
+		Expect(parse.ParseIntArray(ctx, &value)).To(Equal([]int{1, 2}))
+	})
+	It("dereferences *[]string", func() {
+		value := []string{"a"}
+		Expect(parse.ParseStrings(ctx, &value)).To(Equal([]string{"a"}))
+	})
+	It("keeps pointers implementing fmt.Stringer", func() {
+		Expect(parse.ParseString(ctx, big.NewInt(42))).To(Equal("42"))
+		Expect(parse.ParseInt(ctx, big.NewInt(42))).To(Equal(42))
+	})
+	It("returns error for nil pointer", func() {
+		var value *int
+		_, err := parse.ParseInt(ctx, value)
+		Expect(errors.Is(err, parse.ErrNilValue)).To(BeTrue())
+	})
+	It("does not panic for nil pointer implementing fmt.Stringer", func() {
+		var value *time.Time
+		_, err := parse.ParseString(ctx, value)
+		Expect(errors.Is(err, parse.ErrNilValue)).To(BeTrue())
+	})
+})
+
+var _ = Describe("NilPolicy", func() {
+	var ctx context.Context
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+	It("defaults to NilAsError", func() {
+		Expect(parse.NilPolicyFromContext(ctx)).To(Equal(parse.NilAsError))
+	})
+	It("keeps returning nil from ParseStrings without policy", func() {
+		Expect(parse.ParseStrings(ctx, nil)).To(BeNil())
+	})
+	DescribeTable("NilAsError",
+		func(fn func(ctx context.Context, value interface{}) error) {
+			ctx = parse.WithNilPolicy(ctx, parse.NilAsError)
+			err := fn(ctx, nil)
+			Expect(errors.Is(err, parse.ErrNilValue)).To(BeTrue())
+			Expect(errors.Is(err, parse.ErrInvalidType)).To(BeTrue())
+			err = fn(ctx, (*int)(nil))
+			Expect(errors.Is(err, parse.ErrNilValue)).To(BeTrue())
+		},
+		Entry("ParseInt", parseIntErr),
+		Entry("ParseInt64", parseInt64Err),
+		Entry("ParseUint64", parseUint64Err),
+		Entry("ParseFloat64", parseFloat64Err),
+		Entry("ParseBool", parseBoolErr),
+		Entry("ParseString", func(ctx context.Context, value interface{}) error {
+			_, err := parse.ParseString(ctx, value)
+			return err
+		}),
+		Entry("ParseStrings", func(ctx context.Context, value interface{}) error {
+			_, err := parse.ParseStrings(ctx, value)
+			return err
+		}),
+		Entry("ParseIntArray", func(ctx context.Context, value interface{}) error {
+			_, err := parse.ParseIntArray(ctx, value)
+			return err
+		}),
+		Entry("ParseTime", func(ctx context.Context, value interface{}) error {
+			_, err := parse.ParseTime(ctx, value, time.RFC3339)
+			return err
+		}),
+	)
+	It("returns zero values with NilAsZero", func() {
+		ctx = parse.WithNilPolicy(ctx, parse.NilAsZero)
+		Expect(parse.ParseInt(ctx, nil)).To(Equal(0))
+		Expect(parse.ParseInt64(ctx, (*int64)(nil))).To(Equal(int64(0)))
+		Expect(parse.ParseUint32(ctx, nil)).To(Equal(uint32(0)))
+		Expect(parse.ParseFloat64(ctx, nil)).To(Equal(0.0))
+		Expect(parse.ParseBool(ctx, nil)).To(BeFalse())
+		Expect(parse.ParseString(ctx, nil)).To(Equal(""))
+		Expect(parse.ParseStrings(ctx, nil)).To(BeNil())
+		Expect(parse.ParseIntArray(ctx, nil)).To(BeNil())
+		Expect(parse.ParseTime(ctx, nil, time.RFC3339)).To(Equal(time.Time{}))
+		Expect(parse.Parse[Port](ctx, nil)).To(Equal(Port(0)))
+		Expect(parse.ParseIntDefault(ctx, nil, 42)).To(Equal(0))
+	})
+	It("returns zero values from ParseX and default values from ParseXDefault with NilAsDefault", func() {
+		ctx = parse.WithNilPolicy(ctx, parse.NilAsDefault)
+		Expect(parse.ParseInt(ctx, nil)).To(Equal(0))
+		Expect(parse.ParseIntDefault(ctx, nil, 42)).To(Equal(42))
+		Expect(parse.ParseStringDefault(ctx, (*string)(nil), "default")).To(Equal("default"))
+		Expect(parse.ParseStringsDefault(ctx, nil, []string{"a"})).To(Equal([]string{"a"}))
+		Expect(parse.ParseDefault(ctx, nil, Port(80))).To(Equal(Port(80)))
+	})
+	It("returns default values from ParseXDefault with NilAsError", func() {
+		ctx = parse.WithNilPolicy(ctx, parse.NilAsError)
+		Expect(parse.ParseIntDefault(ctx, nil, 42)).To(Equal(42))
+		Expect(parse.ParseStringsDefault(ctx, nil, []string{"a"})).To(Equal([]string{"a"}))
+	})
+})
@@ -0,0 +1,122 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+	"time"
+)
+
+// Optional holds a value that may be absent.
+// Valid is false if the value is absent, which allows to distinguish absent values from zero values.
+type Optional[T any] struct {
+	Value T
+	Valid bool
+}
+
+// NewOptional returns a valid Optional holding value.
+func NewOptional[T any](value T) Optional[T] {
+	return Optional[T]{
+		Value: value,
+		Valid: true,
+	}
+}
+
+// Null converts the Optional to a sql.Null, which implements driver.Valuer.
+func (o Optional[T]) Null() sql.Null[T] {
+	return sql.Null[T]{
+		V:     o.Value,
+		Valid: o.Valid,
+	}
+}
+
+// Scan implements sql.Scanner. NULL results in an Optional with Valid false,
+// all other values are converted with Parse. []byte is copied if T is a byte slice and treated
+// as string otherwise.
+func (o *Optional[T]) Scan(src interface{}) error {
+	if bytes, ok := src.([]byte); ok {
+		targetType := reflect.TypeOf((*T)(nil)).Elem()
+		if targetType.Kind() == reflect.Slice && reflect.TypeOf(bytes).ConvertibleTo(targetType) {
+			var value T
+			reflect.ValueOf(&value).Elem().Set(
+				reflect.ValueOf(append([]byte(nil), bytes...)).Convert(targetType),
+			)
+			*o = NewOptional(value)
+			return nil
+		}
+		src = string(bytes)
+	}
+	result, err := ParseOptional[T](context.Background(), src)
+	if err != nil {
+		return err
+	}
+	*o = result
+	return nil
+}
+
+// optionalValue returns the held value or nil if absent.
+// It allows all parsers to accept an Optional as input.
+func (o Optional[T]) optionalValue() interface{} {
+	if !o.Valid {
+		return nil
+	}
+	return o.Value
+}
+
+type optional interface {
+	optionalValue() interface{}
+}
+
+// parseOptional sets o from value using ParseOptional.
+// It allows Parse and Decode to convert into Optional targets.
+func (o *Optional[T]) parseOptional(ctx context.Context, value interface{}) error {
+	result, err := ParseOptional[T](ctx, value)
+	if err != nil {
+		return err
+	}
+	*o = result
+	return nil
+}
+
+type optionalTarget interface {
+	parseOptional(ctx context.Context, value interface{}) error
+}
+
+var optionalTargetType = reflect.TypeOf((*optionalTarget)(nil)).Elem()
+
+// ParseOptional converts an interface{} value to an Optional[T] using Parse.
+// nil, nil pointers, an absent Optional and invalid database/sql Null types like
+// sql.NullInt64{Valid: false} return an Optional with Valid false and no error.
+// Returns a *ParseError if a present value cannot be converted to T.
+func ParseOptional[T any](ctx context.Context, value interface{}) (Optional[T], error) {
+	if indirect(value) == nil {
+		return Optional[T]{}, nil
+	}
+	result, err := Parse[T](ctx, value)
+	if err != nil {
+		return Optional[T]{}, err
+	}
+	return NewOptional(result), nil
+}
+
+// ParseTimeOptional converts an interface{} value to an Optional[time.Time] using ParseTime
+// with the specified format.
+// Absent values are handled like in ParseOptional.
+func ParseTimeOptional(
+	ctx context.Context,
+	value interface{},
+	format string,
+) (Optional[time.Time], error) {
+	if indirect(value) == nil {
+		return Optional[time.Time]{}, nil
+	}
+	result, err := ParseTime(ctx, value, format)
+	if err != nil {
+		return Optional[time.Time]{}, err
+	}
+	return NewOptional(result), nil
+}
@@ -0,0 +1,163 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/parse"
+)
+
+var _ = DescribeTable("ParseOptional",
+	func(value interface{}, expectedResult parse.Optional[int64], expectError bool) {
+		result, err := parse.ParseOptional[int64](context.Background(), value)
+		if expectError {
+			Expect(err).NotTo(BeNil())
+			Expect(result).To(Equal(parse.Optional[int64]{}))
+		} else {
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(expectedResult))
+		}
+	},
+	Entry("nil", nil, parse.Optional[int64]{}, false),
+	Entry("nil pointer", (*int64)(nil), parse.Optional[int64]{}, false),
+	Entry("zero", 0, parse.NewOptional(int64(0)), false),
+	Entry("string", "42", parse.NewOptional(int64(42)), false),
+	Entry("invalid sql.NullInt64", sql.NullInt64{}, parse.Optional[int64]{}, false),
+	Entry(
+		"valid sql.NullInt64",
+		sql.NullInt64{Int64: 42, Valid: true},
+		parse.NewOptional(int64(42)),
+		false,
+	),
+	Entry(
+		"valid sql.NullString",
+		sql.NullString{String: "42", Valid: true},
+		parse.NewOptional(int64(42)),
+		false,
+	),
+	Entry("invalid sql.Null", sql.Null[int]{}, parse.Optional[int64]{}, false),
+	Entry("valid sql.Null", sql.Null[int]{V: 42, Valid: true}, parse.NewOptional(int64(42)), false),
+	Entry("absent Optional", parse.Optional[string]{}, parse.Optional[int64]{}, false),
+	Entry("present Optional", parse.NewOptional("42"), parse.NewOptional(int64(42)), false),
+	Entry("invalid", "banana", parse.Optional[int64]{}, true),
+)
+
+var _ = Describe("ParseTimeOptional", func() {
+	It("returns absent for invalid sql.NullTime", func() {
+		result, err := parse.ParseTimeOptional(context.Background(), sql.NullTime{}, time.RFC3339)
+		Expect(err).To(BeNil())
+		Expect(result.Valid).To(BeFalse())
+	})
+	It("returns present for string", func() {
+		result, err := parse.ParseTimeOptional(
+			context.Background(),
+			"2023-12-25T10:30:00Z",
+			time.RFC3339,
+		)
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal(parse.NewOptional(time.Date(2023, 12, 25, 10, 30, 0, 0, time.UTC))))
+	})
+	It("returns present for valid sql.NullTime", func() {
+		value := time.Date(2023, 12, 25, 10, 30, 0, 0, time.UTC)
+		result, err := parse.ParseTimeOptional(
+			context.Background(),
+			sql.NullTime{Time: value, Valid: true},
+			time.RFC3339,
+		)
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal(parse.NewOptional(value)))
+	})
+	It("returns error for invalid value", func() {
+		_, err := parse.ParseTimeOptional(context.Background(), "banana", time.RFC3339)
+		Expect(err).NotTo(BeNil())
+	})
+})
+
+var _ = Describe("Optional", func() {
+	It("converts to sql.Null", func() {
+		Expect(parse.NewOptional(42).Null()).To(Equal(sql.Null[int]{V: 42, Valid: true}))
+		Expect(parse.Optional[int]{}.Null()).To(Equal(sql.Null[int]{}))
+	})
+	It("provides driver value via sql.Null", func() {
+		value, err := parse.Optional[int64]{}.Null().Value()
+		Expect(err).To(BeNil())
+		Expect(value).To(BeNil())
+		value, err = parse.NewOptional(int64(42)).Null().Value()
+		Expect(err).To(BeNil())
+		Expect(value).To(Equal(int64(42)))
+	})
+	It("scans NULL", func() {
+		optional := parse.NewOptional(42)
+		Expect(optional.Scan(nil)).To(BeNil())
+		Expect(optional.Valid).To(BeFalse())
+	})
+	It("scans value", func() {
+		var optional parse.Optional[int]
+		Expect(optional.Scan(int64(42))).To(BeNil())
+		Expect(optional).To(Equal(parse.NewOptional(42)))
+	})
+	It("scans bytes", func() {
+		var optional parse.Optional[int]
+		Expect(optional.Scan([]byte("42"))).To(BeNil())
+		Expect(optional).To(Equal(parse.NewOptional(42)))
+	})
+	It("scans bytes into byte slices", func() {
+		src := []byte("abc")
+		var optional parse.Optional[[]byte]
+		Expect(optional.Scan(src)).To(BeNil())
+		Expect(optional).To(Equal(parse.NewOptional([]byte("abc"))))
+		src[0] = 'x'
+		Expect(optional.Value).To(Equal([]byte("abc")))
+	})
+	It("returns error for invalid value", func() {
+		var optional parse.Optional[int]
+		Expect(optional.Scan("banana")).NotTo(BeNil())
+	})
+	It("is accepted by scalar parsers", func() {
+		ctx := context.Background()
+		Expect(parse.ParseInt(ctx, parse.NewOptional("42"))).To(Equal(42))
+		_, err := parse.ParseInt(ctx, parse.Optional[int]{})
+		Expect(err).To(MatchError(parse.ErrNilValue))
+	})
+	It("accepts sql.Null types in scalar parsers", func() {
+		ctx := context.Background()
+		Expect(parse.ParseString(ctx, sql.NullInt64{Int64: 42, Valid: true})).To(Equal("42"))
+		Expect(parse.ParseBool(ctx, sql.NullBool{Bool: true, Valid: true})).To(BeTrue())
+	})
+	It("keeps String of other driver.Valuer types", func() {
+		ctx := context.Background()
+		Expect(parse.ParseString(ctx, SQLStatus(1))).To(Equal("active"))
+		Expect(parse.ParseStrings(ctx, []SQLStatus{1})).To(Equal([]string{"active"}))
+		Expect(parse.ParseString(ctx, SQLBlob("data"))).To(Equal("data"))
+	})
+})
+
+// SQLStatus is an enum implementing fmt.Stringer and driver.Valuer.
+type SQLStatus int
+
+func (s SQLStatus) String() string {
+	if s == 1 {
+		return "active"
+	}
+	return "inactive"
+}
+
+func (s SQLStatus) Value() (driver.Value, error) {
+	return int64(s), nil
+}
+
+// SQLBlob is a driver.Valuer returning []byte.
+type SQLBlob string
+
+func (b SQLBlob) Value() (driver.Value, error) {
+	return []byte(b), nil
+}
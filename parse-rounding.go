@@ -0,0 +1,177 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse
+
+import (
+	"context"
+	stderrors "errors"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/bborbe/errors"
+)
+
+// ErrNonIntegral is returned by the integer parsers for values with a fractional part
+// if RoundReject is configured.
+var ErrNonIntegral = stderrors.New("non-integral value")
+
+// RoundingMode defines how the integer parsers convert float values and decimal strings.
+type RoundingMode int
+
+const (
+	// RoundHalfAwayFromZero rounds to the nearest integer, halves away from zero. This is the default.
+	RoundHalfAwayFromZero RoundingMode = iota
+	// RoundHalfEven rounds to the nearest integer, halves to the nearest even integer.
+	RoundHalfEven
+	// RoundTruncate drops the fractional part.
+	RoundTruncate
+	// RoundFloor rounds towards negative infinity.
+	RoundFloor
+	// RoundCeil rounds towards positive infinity.
+	RoundCeil
+	// RoundReject returns ErrNonIntegral for values with a fractional part.
+	RoundReject
+)
+
+type roundingModeContextKey struct{}
+
+// WithRoundingMode returns a copy of ctx that makes ParseInt, ParseInt64 and the unsigned
+// parsers convert float values and decimal strings using mode.
+func WithRoundingMode(ctx context.Context, mode RoundingMode) context.Context {
+	return context.WithValue(ctx, roundingModeContextKey{}, mode)
+}
+
+// RoundingModeFromContext returns the RoundingMode stored in ctx or RoundHalfAwayFromZero.
+func RoundingModeFromContext(ctx context.Context) RoundingMode {
+	mode, ok := ctx.Value(roundingModeContextKey{}).(RoundingMode)
+	if !ok {
+		return RoundHalfAwayFromZero
+	}
+	return mode
+}
+
+// roundFloat64 converts v to an integral float64 using the RoundingMode of ctx.
+// NaN and infinite values are returned unchanged so the caller can report them as overflow.
+func roundFloat64(ctx context.Context, v float64) (float64, error) {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return v, nil
+	}
+	switch RoundingModeFromContext(ctx) {
+	case RoundHalfEven:
+		return math.RoundToEven(v), nil
+	case RoundTruncate:
+		return math.Trunc(v), nil
+	case RoundFloor:
+		return math.Floor(v), nil
+	case RoundCeil:
+		return math.Ceil(v), nil
+	case RoundReject:
+		if v != math.Trunc(v) {
+			return 0, errors.Wrapf(ctx, ErrNonIntegral, "value %v has a fractional part", v)
+		}
+		return v, nil
+	default:
+		return math.Round(v), nil
+	}
+}
+
+// maxDecimalDigits is the number of integer digits above which roundDecimalString leaves a
+// decimal string to the float path, which reports it as overflow.
+const maxDecimalDigits = 40
+
+// roundDecimalString converts the decimal string v like "2.5" or "1.5e3" exactly to an integer
+// using the RoundingMode of ctx, without the precision loss of a float64 round-trip.
+// Reports false if v is no plain decimal string, e.g. "NaN", a hex float or has more than
+// maxDecimalDigits integer digits.
+func roundDecimalString(ctx context.Context, v string) (*big.Int, bool, error) {
+	digits, point, negative, ok := splitDecimalString(v)
+	if !ok || point > maxDecimalDigits {
+		return nil, false, nil
+	}
+	integral, fraction := "0", digits
+	switch {
+	case point >= len(digits):
+		integral, fraction = digits+strings.Repeat("0", point-len(digits)), ""
+	case point > 0:
+		integral, fraction = digits[:point], digits[point:]
+	case point < 0:
+		// the fraction starts with -point zeros, so it is less than a half
+		fraction = "0" + fraction
+	}
+	result, _ := new(big.Int).SetString(integral, 10)
+	fraction = strings.TrimRight(fraction, "0")
+	if fraction != "" {
+		roundUp, err := roundUpDecimal(ctx, v, fraction, negative, result.Bit(0) == 1)
+		if err != nil {
+			return nil, true, err
+		}
+		if roundUp {
+			result.Add(result, big.NewInt(1))
+		}
+	}
+	if negative {
+		result.Neg(result)
+	}
+	return result, true, nil
+}
+
+// splitDecimalString splits v into its significant digits, the position of the decimal point
+// within them and the sign.
+func splitDecimalString(v string) (string, int, bool, bool) {
+	negative := strings.HasPrefix(v, "-")
+	if negative || strings.HasPrefix(v, "+") {
+		v = v[1:]
+	}
+	mantissa, exponent, hasExponent := v, "", false
+	if i := strings.IndexAny(v, "eE"); i >= 0 {
+		mantissa, exponent, hasExponent = v[:i], v[i+1:], true
+	}
+	integral, fraction, _ := strings.Cut(mantissa, ".")
+	digits := integral + fraction
+	if digits == "" || strings.Trim(digits, "0123456789") != "" {
+		return "", 0, false, false
+	}
+	point := len(integral)
+	if hasExponent {
+		exp, err := strconv.Atoi(exponent)
+		if err != nil || exp > len(digits)+maxDecimalDigits || exp < math.MinInt32 {
+			return "", 0, false, false
+		}
+		point += exp
+	}
+	trimmed := strings.TrimLeft(digits, "0")
+	if trimmed == "" {
+		return "0", 1, negative, true
+	}
+	return trimmed, point - (len(digits) - len(trimmed)), negative, true
+}
+
+// roundUpDecimal reports whether the magnitude of a value with the non-zero decimal fraction
+// digits has to be rounded up according to the RoundingMode of ctx.
+func roundUpDecimal(
+	ctx context.Context,
+	value string,
+	fraction string,
+	negative bool,
+	odd bool,
+) (bool, error) {
+	half := strings.Compare(fraction, "5")
+	switch RoundingModeFromContext(ctx) {
+	case RoundHalfEven:
+		return half > 0 || half == 0 && odd, nil
+	case RoundTruncate:
+		return false, nil
+	case RoundFloor:
+		return negative, nil
+	case RoundCeil:
+		return !negative, nil
+	case RoundReject:
+		return false, errors.Wrapf(ctx, ErrNonIntegral, "value %v has a fractional part", value)
+	default:
+		return half >= 0, nil
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/parse"
+)
+
+var _ = DescribeTable("RoundingMode",
+	func(mode parse.RoundingMode, value interface{}, expectedResult int64, expectError bool) {
+		ctx := parse.WithRoundingMode(context.Background(), mode)
+		result, err := parse.ParseInt64(ctx, value)
+		if expectError {
+			Expect(err).NotTo(BeNil())
+			Expect(result).To(Equal(int64(0)))
+		} else {
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(expectedResult))
+		}
+	},
+	Entry("half away 2.5", parse.RoundHalfAwayFromZero, 2.5, int64(3), false),
+	Entry("half away -2.5", parse.RoundHalfAwayFromZero, -2.5, int64(-3), false),
+	Entry("half away string 2.5", parse.RoundHalfAwayFromZero, "2.5", int64(3), false),
+	Entry("half even 2.5", parse.RoundHalfEven, 2.5, int64(2), false),
+	Entry("half even 3.5", parse.RoundHalfEven, 3.5, int64(4), false),
+	Entry("half even string 2.5", parse.RoundHalfEven, "2.5", int64(2), false),
+	Entry("truncate 2.7", parse.RoundTruncate, 2.7, int64(2), false),
+	Entry("truncate -2.7", parse.RoundTruncate, -2.7, int64(-2), false),
+	Entry("floor -2.2", parse.RoundFloor, -2.2, int64(-3), false),
+	Entry("floor string 2.9", parse.RoundFloor, "2.9", int64(2), false),
+	Entry("ceil 2.1", parse.RoundCeil, 2.1, int64(3), false),
+	Entry("ceil float32", parse.RoundCeil, float32(2.1), int64(3), false),
+	Entry("reject 2.0", parse.RoundReject, 2.0, int64(2), false),
+	Entry("reject string 2.0", parse.RoundReject, "2.0", int64(2), false),
+	Entry("reject 2.5", parse.RoundReject, 2.5, int64(0), true),
+	Entry("reject string 2.5", parse.RoundReject, "2.5", int64(0), true),
+	Entry("reject integer string", parse.RoundReject, "2", int64(2), false),
+	Entry("half away string -2.5", parse.RoundHalfAwayFromZero, "-2.5", int64(-3), false),
+	Entry(
+		"half away string below half",
+		parse.RoundHalfAwayFromZero,
+		"0.49999999999999999999",
+		int64(0),
+		false,
+	),
+	Entry("half even string 3.5", parse.RoundHalfEven, "3.5", int64(4), false),
+	Entry("half even string 2.51", parse.RoundHalfEven, "2.51", int64(3), false),
+	Entry("truncate string -2.7", parse.RoundTruncate, "-2.7", int64(-2), false),
+	Entry("floor string -2.2", parse.RoundFloor, "-2.2", int64(-3), false),
+	Entry("ceil string -2.1", parse.RoundCeil, "-2.1", int64(-2), false),
+	Entry("ceil string tiny", parse.RoundCeil, "1e-400", int64(1), false),
+	Entry("reject string 2.5e1", parse.RoundReject, "2.5e1", int64(25), false),
+	Entry("reject string 2.55e1", parse.RoundReject, "2.55e1", int64(0), true),
+)
+
+var _ = Describe("RoundingMode", func() {
+	It("defaults to RoundHalfAwayFromZero", func() {
+		Expect(parse.RoundingModeFromContext(context.Background())).
+			To(Equal(parse.RoundHalfAwayFromZero))
+	})
+	It("treats native floats and decimal strings the same in ParseInt", func() {
+		ctx := parse.WithRoundingMode(context.Background(), parse.RoundHalfEven)
+		fromFloat, err := parse.ParseInt(ctx, 2.5)
+		Expect(err).To(BeNil())
+		fromString, err := parse.ParseInt(ctx, "2.5")
+		Expect(err).To(BeNil())
+		Expect(fromFloat).To(Equal(fromString))
+	})
+	It("applies to unsigned parsers", func() {
+		ctx := parse.WithRoundingMode(context.Background(), parse.RoundFloor)
+		result, err := parse.ParseUint64(ctx, "2.9")
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal(uint64(2)))
+	})
+	It("returns ErrNonIntegral for RoundReject", func() {
+		ctx := parse.WithRoundingMode(context.Background(), parse.RoundReject)
+		_, err := parse.ParseInt(ctx, 2.5)
+		Expect(err).To(MatchError(parse.ErrNonIntegral))
+	})
+})
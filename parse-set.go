@@ -0,0 +1,143 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse
+
+import (
+	"context"
+	"reflect"
+	"strings"
+)
+
+// Folding defines how ParseSet and ParseStringSet compare string values.
+// Foldings can be combined, e.g. FoldCase|FoldASCII.
+type Folding int
+
+const (
+	// FoldNone compares values exactly. This is the default.
+	FoldNone Folding = 0
+	// FoldCase compares strings case-insensitively.
+	FoldCase Folding = 1 << 0
+	// FoldASCII compares strings after converting them with ParseASCII, e.g. "café" equals "cafe".
+	FoldASCII Folding = 1 << 1
+)
+
+type foldingContextKey struct{}
+
+// WithFolding returns a copy of ctx that makes ParseSet and ParseStringSet compare string values
+// according to folding.
+func WithFolding(ctx context.Context, folding Folding) context.Context {
+	return context.WithValue(ctx, foldingContextKey{}, folding)
+}
+
+// FoldingFromContext returns the Folding stored in ctx or FoldNone.
+func FoldingFromContext(ctx context.Context) Folding {
+	folding, _ := ctx.Value(foldingContextKey{}).(Folding)
+	return folding
+}
+
+// Set is a set of unique values that preserves the order in which values were first added.
+// The zero value is an empty set ready to use. A Set must not be copied after first use, pass
+// the *Set returned by NewSet, ParseSet and ParseStringSet instead.
+type Set[T comparable] struct {
+	values []T
+	index  map[T]struct{}
+	key    func(T) T
+}
+
+// NewSet returns a Set holding the unique values in first-seen order.
+func NewSet[T comparable](values ...T) *Set[T] {
+	result := &Set[T]{}
+	for _, value := range values {
+		result.Add(value)
+	}
+	return result
+}
+
+// Add adds value and reports whether it was not yet contained.
+func (s *Set[T]) Add(value T) bool {
+	if s.Contains(value) {
+		return false
+	}
+	if s.index == nil {
+		s.index = make(map[T]struct{})
+	}
+	s.index[s.keyOf(value)] = struct{}{}
+	s.values = append(s.values, value)
+	return true
+}
+
+// Contains reports whether value is in the set, compared with the Folding the set was parsed with.
+func (s *Set[T]) Contains(value T) bool {
+	_, ok := s.index[s.keyOf(value)]
+	return ok
+}
+
+// Values returns a copy of the values in first-seen order.
+func (s *Set[T]) Values() []T {
+	result := make([]T, len(s.values))
+	copy(result, s.values)
+	return result
+}
+
+// Len returns the number of values.
+func (s *Set[T]) Len() int {
+	return len(s.values)
+}
+
+func (s *Set[T]) keyOf(value T) T {
+	if s.key == nil {
+		return value
+	}
+	return s.key(value)
+}
+
+// ParseSet converts an interface{} value to a Set of T, keeping the first occurrence of
+// duplicate values.
+// Accepts everything Parse accepts for []T, i.e. ParseStrings input for string types and
+// ParseSlice input for all others.
+// String values are compared according to the Folding of WithFolding, the first-seen spelling
+// is kept.
+// Returns a *ParseError if the value cannot be converted.
+func ParseSet[T comparable](ctx context.Context, value interface{}) (*Set[T], error) {
+	values, err := Parse[[]T](ctx, value)
+	if err != nil {
+		return nil, err
+	}
+	result := &Set[T]{
+		key: foldKey[T](ctx),
+	}
+	for _, v := range values {
+		result.Add(v)
+	}
+	return result, nil
+}
+
+// ParseStringSet converts an interface{} value to a Set of strings, see ParseSet.
+func ParseStringSet(ctx context.Context, value interface{}) (*Set[string], error) {
+	return ParseSet[string](ctx, value)
+}
+
+// foldKey returns the function folding values of T according to the Folding of ctx,
+// or nil if T is no string type or no Folding is configured.
+func foldKey[T comparable](ctx context.Context) func(T) T {
+	folding := FoldingFromContext(ctx)
+	if folding == FoldNone || reflect.TypeOf((*T)(nil)).Elem().Kind() != reflect.String {
+		return nil
+	}
+	return func(value T) T {
+		v := reflect.ValueOf(&value).Elem()
+		str := v.String()
+		if folding&FoldASCII != 0 {
+			if ascii, err := ParseASCII(ctx, str); err == nil {
+				str = ascii
+			}
+		}
+		if folding&FoldCase != 0 {
+			str = strings.ToLower(str)
+		}
+		v.SetString(str)
+		return value
+	}
+}
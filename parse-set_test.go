@@ -0,0 +1,136 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/parse"
+)
+
+var _ = DescribeTable("ParseStringSet",
+	func(ctx context.Context, value interface{}, expectedValues []string, expectError bool) {
+		result, err := parse.ParseStringSet(ctx, value)
+		if expectError {
+			Expect(err).NotTo(BeNil())
+			Expect(result).To(BeNil())
+		} else {
+			Expect(err).To(BeNil())
+			Expect(result.Values()).To(Equal(expectedValues))
+			Expect(result.Len()).To(Equal(len(expectedValues)))
+		}
+	},
+	Entry(
+		"deduplicates in first-seen order",
+		context.Background(),
+		[]string{"b", "a", "b", "c", "a"},
+		[]string{"b", "a", "c"},
+		false,
+	),
+	Entry(
+		"case sensitive by default",
+		context.Background(),
+		[]string{"A", "a"},
+		[]string{"A", "a"},
+		false,
+	),
+	Entry(
+		"case insensitive",
+		parse.WithFolding(context.Background(), parse.FoldCase),
+		[]string{"Admin", "admin", "ADMIN", "user"},
+		[]string{"Admin", "user"},
+		false,
+	),
+	Entry(
+		"ascii folding",
+		parse.WithFolding(context.Background(), parse.FoldASCII),
+		[]string{"café", "cafe", "Cafe"},
+		[]string{"café", "Cafe"},
+		false,
+	),
+	Entry(
+		"ascii and case folding",
+		parse.WithFolding(context.Background(), parse.FoldASCII|parse.FoldCase),
+		[]string{"Café", "cafe"},
+		[]string{"Café"},
+		false,
+	),
+	Entry("single string", context.Background(), "a", []string{"a"}, false),
+	Entry(
+		"split string",
+		parse.WithSplit(context.Background(), parse.SplitOptions{}),
+		"a,b,a",
+		[]string{"a", "b"},
+		false,
+	),
+	Entry(
+		"[]interface{}",
+		context.Background(),
+		[]interface{}{"a", 1, "1"},
+		[]string{"a", "1"},
+		false,
+	),
+	Entry("invalid", context.Background(), []interface{}{[]int{1}}, nil, true),
+)
+
+var _ = DescribeTable("ParseSet[int]",
+	func(value interface{}, expectedValues []int, expectError bool) {
+		result, err := parse.ParseSet[int](context.Background(), value)
+		if expectError {
+			Expect(err).NotTo(BeNil())
+		} else {
+			Expect(err).To(BeNil())
+			Expect(result.Values()).To(Equal(expectedValues))
+		}
+	},
+	Entry("deduplicates", []interface{}{3, "1", 3.0, 1}, []int{3, 1}, false),
+	Entry("array", [3]int{1, 1, 2}, []int{1, 2}, false),
+	Entry("invalid", []string{"x"}, nil, true),
+)
+
+var _ = Describe("Set", func() {
+	It("supports membership queries", func() {
+		set := parse.NewSet("a", "b", "a")
+		Expect(set.Contains("a")).To(BeTrue())
+		Expect(set.Contains("c")).To(BeFalse())
+		Expect(set.Values()).To(Equal([]string{"a", "b"}))
+	})
+	It("uses folding for membership queries", func() {
+		ctx := parse.WithFolding(context.Background(), parse.FoldCase)
+		set, err := parse.ParseStringSet(ctx, []string{"Admin"})
+		Expect(err).To(BeNil())
+		Expect(set.Contains("ADMIN")).To(BeTrue())
+	})
+	It("adds values", func() {
+		var set parse.Set[int]
+		Expect(set.Contains(1)).To(BeFalse())
+		Expect(set.Add(1)).To(BeTrue())
+		Expect(set.Add(1)).To(BeFalse())
+		Expect(set.Len()).To(Equal(1))
+	})
+	It("shares values between references", func() {
+		set := parse.NewSet("a")
+		other := set
+		Expect(other.Add("b")).To(BeTrue())
+		Expect(set.Contains("b")).To(BeTrue())
+		Expect(set.Values()).To(Equal([]string{"a", "b"}))
+		Expect(set.Len()).To(Equal(2))
+	})
+	It("returns a copy of values", func() {
+		set := parse.NewSet(1, 2)
+		values := set.Values()
+		values[0] = 5
+		Expect(set.Values()).To(Equal([]int{1, 2}))
+	})
+	It("supports named string types", func() {
+		ctx := parse.WithFolding(context.Background(), parse.FoldCase)
+		set, err := parse.ParseSet[Direction](ctx, []string{"North", "north"})
+		Expect(err).To(BeNil())
+		Expect(set.Values()).To(Equal([]Direction{"North"}))
+	})
+})
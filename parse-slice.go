@@ -0,0 +1,38 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse
+
+import (
+	"context"
+	"reflect"
+)
+
+// ParseSlice converts an interface{} value to a slice of T.
+// Accepts any slice or array, e.g. []interface{}, []uint8, []int16, []MyInt, [3]int or
+// []json.Number, and converts each element with the parser matching T, see Parse.
+// Returns a *ParseError with the index of the element in Path if any element cannot be
+// converted to T, or ParseErrors with all failing elements if WithCollectErrors is set.
+func ParseSlice[T any](ctx context.Context, value interface{}) ([]T, error) {
+	var result []T
+	parsed, err := parseSliceElements(ctx, value, reflect.TypeOf(result))
+	if err != nil {
+		return nil, err
+	}
+	reflect.ValueOf(&result).Elem().Set(parsed)
+	return result, nil
+}
+
+// ParseSliceDefault converts an interface{} value to a slice of T, returning defaultValue on error.
+// This is a convenience wrapper around ParseSlice that never returns an error.
+func ParseSliceDefault[T any](ctx context.Context, value interface{}, defaultValue []T) []T {
+	if isNilDefault(ctx, value) {
+		return defaultValue
+	}
+	result, err := ParseSlice[T](ctx, value)
+	if err != nil {
+		return defaultValue
+	}
+	return result
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse_test
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/parse"
+)
+
+var _ = DescribeTable("ParseSlice[int]",
+	func(input interface{}, expectedResult []int, hasError bool) {
+		result, err := parse.ParseSlice[int](context.Background(), input)
+		Expect(result).To(Equal(expectedResult))
+		if hasError {
+			Expect(err).NotTo(BeNil())
+			Expect(stderrors.Is(err, parse.ErrInvalidType)).To(BeTrue())
+		} else {
+			Expect(err).To(BeNil())
+		}
+	},
+	Entry("[]int", []int{1, 2, 3}, []int{1, 2, 3}, false),
+	Entry("[]uint8", []uint8{1, 2}, []int{1, 2}, false),
+	Entry("[]int16", []int16{-1, 2}, []int{-1, 2}, false),
+	Entry("[]MyInt", []MyInt{1, 2}, []int{1, 2}, false),
+	Entry("[3]int", [3]int{1, 2, 3}, []int{1, 2, 3}, false),
+	Entry("[]json.Number", []json.Number{"1", "2"}, []int{1, 2}, false),
+	Entry("pointer to slice", &[]string{"1"}, []int{1}, false),
+	Entry("empty", []interface{}{}, []int{}, false),
+	Entry("invalid element", []interface{}{1, "x"}, nil, true),
+	Entry("string", "1", nil, true),
+	Entry("nil", nil, nil, true),
+)
+
+var _ = Describe("ParseSlice", func() {
+	It("converts to named element types", func() {
+		result, err := parse.ParseSlice[Port](context.Background(), []string{"80", "443"})
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal([]Port{80, 443}))
+	})
+	It("returns path of failing element", func() {
+		_, err := parse.ParseSlice[int64](context.Background(), [2]string{"1", "x"})
+		var parseErr *parse.ParseError
+		Expect(stderrors.As(err, &parseErr)).To(BeTrue())
+		Expect(parseErr.Path).To(Equal("[1]"))
+	})
+})
+
+var _ = DescribeTable("ParseSliceDefault",
+	func(input interface{}, defaultValue []int, expectedResult []int) {
+		result := parse.ParseSliceDefault(context.Background(), input, defaultValue)
+		Expect(result).To(Equal(expectedResult))
+	},
+	Entry("valid", []string{"1"}, []int{9}, []int{1}),
+	Entry("invalid", "x", []int{9}, []int{9}),
+)
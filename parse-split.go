@@ -0,0 +1,173 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/bborbe/errors"
+)
+
+// EmptyPolicy defines how Split handles empty elements like the middle one of "a,,b".
+type EmptyPolicy int
+
+const (
+	// EmptyKeep keeps empty elements. This is the default.
+	EmptyKeep EmptyPolicy = iota
+	// EmptySkip drops empty elements.
+	EmptySkip
+	// EmptyAsError returns an error wrapping ErrInvalidType for empty elements.
+	EmptyAsError
+)
+
+// SplitOptions configure how Split and the array parsers split delimited strings.
+type SplitOptions struct {
+	// Separator between elements, "," if empty.
+	Separator string
+	// Trim removes leading and trailing whitespace of each element.
+	Trim bool
+	// Empty defines how empty elements are handled. Quoted empty elements ("") are always kept.
+	Empty EmptyPolicy
+	// Quotes allows elements enclosed in double quotes to contain the separator,
+	// a double quote inside a quoted element is written as "".
+	Quotes bool
+}
+
+type splitOptionsContextKey struct{}
+
+// WithSplit returns a copy of ctx that makes ParseStrings, ParseSlice and all array parsers
+// split string input with Split using options, e.g. "1,2,3" becomes []int{1, 2, 3}.
+// Without WithSplit, ParseStrings returns a string as a slice with one element and the other
+// array parsers reject strings.
+func WithSplit(ctx context.Context, options SplitOptions) context.Context {
+	return context.WithValue(ctx, splitOptionsContextKey{}, options)
+}
+
+// SplitOptionsFromContext returns the SplitOptions stored in ctx and whether splitting is enabled.
+func SplitOptionsFromContext(ctx context.Context) (SplitOptions, bool) {
+	options, ok := ctx.Value(splitOptionsContextKey{}).(SplitOptions)
+	return options, ok
+}
+
+// Split splits value into its elements according to options.
+// An empty value results in an empty slice.
+// Returns an error wrapping ErrInvalidType for unterminated quotes, text after a closing quote
+// and empty elements with EmptyAsError.
+func Split(ctx context.Context, value string, options SplitOptions) ([]string, error) {
+	separator := options.Separator
+	if separator == "" {
+		separator = ","
+	}
+	result := []string{}
+	if value == "" {
+		return result, nil
+	}
+	rest := value
+	for more := true; more; {
+		var element string
+		var quoted bool
+		var err error
+		element, rest, more, quoted, err = splitElement(ctx, rest, separator, options)
+		if err != nil {
+			return nil, err
+		}
+		if element == "" && !quoted {
+			switch options.Empty {
+			case EmptySkip:
+				continue
+			case EmptyAsError:
+				return nil, errors.Wrapf(
+					ctx,
+					ErrInvalidType,
+					"empty element at index %d",
+					len(result),
+				)
+			}
+		}
+		result = append(result, element)
+	}
+	return result, nil
+}
+
+// splitElement returns the first element of value, the remaining input and whether another
+// element follows.
+func splitElement(
+	ctx context.Context,
+	value string,
+	separator string,
+	options SplitOptions,
+) (element string, rest string, more bool, quoted bool, err error) {
+	if options.Quotes {
+		candidate := value
+		if options.Trim {
+			candidate = strings.TrimLeftFunc(candidate, unicode.IsSpace)
+		}
+		if strings.HasPrefix(candidate, `"`) {
+			element, rest, more, err = splitQuotedElement(ctx, candidate[1:], separator, options)
+			return element, rest, more, true, err
+		}
+	}
+	element, rest, more = strings.Cut(value, separator)
+	if options.Trim {
+		element = strings.TrimSpace(element)
+	}
+	return element, rest, more, false, nil
+}
+
+// splitQuotedElement returns the quoted element at the start of value, which starts after the
+// opening quote.
+func splitQuotedElement(
+	ctx context.Context,
+	value string,
+	separator string,
+	options SplitOptions,
+) (string, string, bool, error) {
+	var element strings.Builder
+	for i := 0; i < len(value); i++ {
+		if value[i] != '"' {
+			element.WriteByte(value[i])
+			continue
+		}
+		if i+1 < len(value) && value[i+1] == '"' {
+			element.WriteByte('"')
+			i++
+			continue
+		}
+		rest := value[i+1:]
+		if options.Trim {
+			rest = strings.TrimLeftFunc(rest, unicode.IsSpace)
+		}
+		if rest == "" {
+			return element.String(), "", false, nil
+		}
+		if !strings.HasPrefix(rest, separator) {
+			return "", "", false, errors.Wrapf(
+				ctx,
+				ErrInvalidType,
+				"unexpected text after quoted element '%s'",
+				element.String(),
+			)
+		}
+		return element.String(), rest[len(separator):], true, nil
+	}
+	return "", "", false, errors.Wrapf(ctx, ErrInvalidType, "unterminated quote")
+}
+
+// splitValue splits value with Split if it is a string and WithSplit is set in ctx.
+// All other values are returned unchanged.
+func splitValue(ctx context.Context, value interface{}) (interface{}, error) {
+	options, ok := SplitOptionsFromContext(ctx)
+	if !ok {
+		return value, nil
+	}
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.String {
+		return value, nil
+	}
+	return Split(ctx, v.String(), options)
+}
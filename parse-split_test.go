@@ -0,0 +1,132 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse_test
+
+import (
+	"context"
+	stderrors "errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/parse"
+)
+
+var _ = DescribeTable("Split",
+	func(value string, options parse.SplitOptions, expectedResult []string, expectError bool) {
+		result, err := parse.Split(context.Background(), value, options)
+		if expectError {
+			Expect(err).NotTo(BeNil())
+			Expect(stderrors.Is(err, parse.ErrInvalidType)).To(BeTrue())
+			Expect(result).To(BeNil())
+		} else {
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(expectedResult))
+		}
+	},
+	Entry("default separator", "a,b,c", parse.SplitOptions{}, []string{"a", "b", "c"}, false),
+	Entry("empty string", "", parse.SplitOptions{}, []string{}, false),
+	Entry("single element", "a", parse.SplitOptions{}, []string{"a"}, false),
+	Entry(
+		"custom separator",
+		"a; b",
+		parse.SplitOptions{Separator: "; "},
+		[]string{"a", "b"},
+		false,
+	),
+	Entry("without trim", " a , b ", parse.SplitOptions{}, []string{" a ", " b "}, false),
+	Entry("with trim", " a , b ", parse.SplitOptions{Trim: true}, []string{"a", "b"}, false),
+	Entry("keep empty", "a,,b,", parse.SplitOptions{}, []string{"a", "", "b", ""}, false),
+	Entry(
+		"skip empty",
+		"a,,b, ",
+		parse.SplitOptions{Trim: true, Empty: parse.EmptySkip},
+		[]string{"a", "b"},
+		false,
+	),
+	Entry("empty as error", "a,,b", parse.SplitOptions{Empty: parse.EmptyAsError}, nil, true),
+	Entry("quotes disabled", `"a,b",c`, parse.SplitOptions{}, []string{`"a`, `b"`, "c"}, false),
+	Entry(
+		"quoted separator",
+		`"a,b",c`,
+		parse.SplitOptions{Quotes: true},
+		[]string{"a,b", "c"},
+		false,
+	),
+	Entry(
+		"escaped quote",
+		`"say ""hi""",x`,
+		parse.SplitOptions{Quotes: true},
+		[]string{`say "hi"`, "x"},
+		false,
+	),
+	Entry(
+		"quoted with trim",
+		` " a " , b`,
+		parse.SplitOptions{Quotes: true, Trim: true},
+		[]string{" a ", "b"},
+		false,
+	),
+	Entry(
+		"quoted empty is kept",
+		`a,"",b`,
+		parse.SplitOptions{Quotes: true, Empty: parse.EmptySkip},
+		[]string{"a", "", "b"},
+		false,
+	),
+	Entry("unterminated quote", `"a,b`, parse.SplitOptions{Quotes: true}, nil, true),
+	Entry("text after quote", `"a"b,c`, parse.SplitOptions{Quotes: true}, nil, true),
+)
+
+var _ = Describe("WithSplit", func() {
+	var ctx context.Context
+	BeforeEach(func() {
+		ctx = parse.WithSplit(context.Background(), parse.SplitOptions{Trim: true})
+	})
+	It("is disabled by default", func() {
+		_, ok := parse.SplitOptionsFromContext(context.Background())
+		Expect(ok).To(BeFalse())
+	})
+	It("splits for ParseStrings", func() {
+		Expect(parse.ParseStrings(ctx, "a, b")).To(Equal([]string{"a", "b"}))
+	})
+	It("keeps single element for ParseStrings without WithSplit", func() {
+		Expect(parse.ParseStrings(context.Background(), "a, b")).To(Equal([]string{"a, b"}))
+	})
+	It("splits for ParseIntArray", func() {
+		Expect(parse.ParseIntArray(ctx, "1, 2,3")).To(Equal([]int{1, 2, 3}))
+	})
+	It("splits for ParseInt64Array", func() {
+		Expect(parse.ParseInt64Array(ctx, "1,2")).To(Equal([]int64{1, 2}))
+	})
+	It("splits string pointers", func() {
+		value := "1,2"
+		Expect(parse.ParseIntArray(ctx, &value)).To(Equal([]int{1, 2}))
+	})
+	It("splits for best effort parsers", func() {
+		result, skipped, err := parse.ParseIntArrayBestEffort(ctx, "1,x,3")
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal([]int{1, 3}))
+		Expect(skipped).To(HaveLen(1))
+		Expect(skipped[0].Index).To(Equal(1))
+	})
+	It("returns path of invalid element", func() {
+		_, err := parse.ParseIntArray(ctx, "1,x")
+		var parseErr *parse.ParseError
+		Expect(stderrors.As(err, &parseErr)).To(BeTrue())
+		Expect(parseErr.Path).To(Equal("[1]"))
+	})
+	It("returns ParseError for invalid input", func() {
+		ctx = parse.WithSplit(context.Background(), parse.SplitOptions{Quotes: true})
+		_, err := parse.ParseIntArray(ctx, `"1`)
+		var parseErr *parse.ParseError
+		Expect(stderrors.As(err, &parseErr)).To(BeTrue())
+		Expect(parseErr.TargetType).To(Equal("[]int"))
+	})
+	It("rejects strings for ParseIntArray without WithSplit", func() {
+		_, err := parse.ParseIntArray(context.Background(), "1,2")
+		Expect(err).NotTo(BeNil())
+	})
+})
@@ -0,0 +1,59 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/bborbe/errors"
+)
+
+type strictContextKey struct{}
+
+// WithStrict returns a copy of ctx that disables the fmt.Sprintf fallback of the scalar parsers.
+// In strict mode only the documented source types and named types of them (e.g. `type Port int`)
+// are accepted, everything else returns an error wrapping ErrInvalidType.
+func WithStrict(ctx context.Context) context.Context {
+	return context.WithValue(ctx, strictContextKey{}, true)
+}
+
+// IsStrict returns true if strict mode is enabled in ctx.
+func IsStrict(ctx context.Context) bool {
+	strict, _ := ctx.Value(strictContextKey{}).(bool)
+	return strict
+}
+
+// errUnsupportedType returns an error wrapping ErrInvalidType with the concrete type of value.
+func errUnsupportedType(ctx context.Context, value interface{}) error {
+	return errors.Wrapf(ctx, ErrInvalidType, "unsupported type %T", value)
+}
+
+// toBasicKind converts values of named or sized basic types to int64, uint64, float64, string or
+// bool, e.g. MyInt(5) to int64(5) or uint8(5) to uint64(5).
+// Returns false if value is not of a basic kind or already has the resulting type.
+func toBasicKind(value interface{}) (interface{}, bool) {
+	v := reflect.ValueOf(value)
+	var result interface{}
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		result = v.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Uintptr:
+		result = v.Uint()
+	case reflect.Float32, reflect.Float64:
+		result = v.Float()
+	case reflect.String:
+		result = v.String()
+	case reflect.Bool:
+		result = v.Bool()
+	default:
+		return nil, false
+	}
+	if reflect.TypeOf(result) == v.Type() {
+		return nil, false
+	}
+	return result, true
+}
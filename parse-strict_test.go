@@ -0,0 +1,84 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/parse"
+)
+
+var _ = Describe("Strict", func() {
+	var ctx context.Context
+	BeforeEach(func() {
+		ctx = parse.WithStrict(context.Background())
+	})
+	It("is disabled by default", func() {
+		Expect(parse.IsStrict(context.Background())).To(BeFalse())
+	})
+	It("is enabled", func() {
+		Expect(parse.IsStrict(ctx)).To(BeTrue())
+	})
+	It("does not stringify slices", func() {
+		_, err := parse.ParseInt(ctx, []int{5})
+		Expect(err).To(MatchError(parse.ErrInvalidType))
+		Expect(err.Error()).To(ContainSubstring("[]int"))
+	})
+	DescribeTable("rejects undocumented types",
+		func(fn func(ctx context.Context, value interface{}) error, value interface{}) {
+			err := fn(ctx, value)
+			Expect(err).To(MatchError(parse.ErrInvalidType))
+		},
+		Entry("ParseInt slice", parseIntErr, []int{5}),
+		Entry("ParseInt struct", parseIntErr, struct{}{}),
+		Entry("ParseInt map", parseIntErr, map[string]int{"a": 1}),
+		Entry("ParseInt nil", parseIntErr, nil),
+		Entry("ParseInt64 slice", parseInt64Err, []int{5}),
+		Entry("ParseUint64 slice", parseUint64Err, []int{5}),
+		Entry("ParseFloat64 slice", parseFloat64Err, []float64{5}),
+		Entry("ParseBool slice", parseBoolErr, []bool{true}),
+	)
+	DescribeTable("accepts documented types and named types of them",
+		func(fn func(ctx context.Context, value interface{}) error, value interface{}) {
+			Expect(fn(ctx, value)).To(BeNil())
+		},
+		Entry("ParseInt int8", parseIntErr, int8(5)),
+		Entry("ParseInt custom int", parseIntErr, MyInt(5)),
+		Entry("ParseInt stringer", parseIntErr, MyStringer("5")),
+		Entry("ParseInt64 custom int64", parseInt64Err, MyInt64(5)),
+		Entry("ParseInt64 custom string", parseInt64Err, MyString("5")),
+		Entry("ParseUint64 uint8", parseUint64Err, uint8(5)),
+		Entry("ParseFloat64 custom float64", parseFloat64Err, MyFloat64(5)),
+		Entry("ParseBool custom bool", parseBoolErr, MyBool(true)),
+	)
+})
+
+func parseIntErr(ctx context.Context, value interface{}) error {
+	_, err := parse.ParseInt(ctx, value)
+	return err
+}
+
+func parseInt64Err(ctx context.Context, value interface{}) error {
+	_, err := parse.ParseInt64(ctx, value)
+	return err
+}
+
+func parseUint64Err(ctx context.Context, value interface{}) error {
+	_, err := parse.ParseUint64(ctx, value)
+	return err
+}
+
+func parseFloat64Err(ctx context.Context, value interface{}) error {
+	_, err := parse.ParseFloat64(ctx, value)
+	return err
+}
+
+func parseBoolErr(ctx context.Context, value interface{}) error {
+	_, err := parse.ParseBool(ctx, value)
+	return err
+}
@@ -6,22 +6,34 @@ package parse
 
 import (
 	"context"
+	"encoding"
 	stderrors "errors"
 	"fmt"
-	"reflect"
 	"strconv"
-
-	"github.com/bborbe/errors"
 )
 
 // ErrInvalidType is returned when a value cannot be converted to the requested type.
 var ErrInvalidType = stderrors.New("invalid type")
 
 // ParseString converts an interface{} value to a string.
-// Supported types: string, bool, int, int32, int64, uint, uint32, uint64, float32, float64, fmt.Stringer.
-// Custom types derived from string are automatically detected and handled.
-// Returns an error if the value cannot be converted to string.
+// Supported types: string, bool, int, int32, int64, uint, uint32, uint64, float32, float64,
+// fmt.Stringer, encoding.TextMarshaler.
+// Custom types derived from string, bool, integer and float types are automatically detected
+// and handled.
+// Returns a *ParseError if the value cannot be converted to string.
 func ParseString(ctx context.Context, value interface{}) (string, error) {
+	result, err := parseString(ctx, value)
+	if err != nil {
+		return "", newParseError(value, "string", err)
+	}
+	return result, nil
+}
+
+func parseString(ctx context.Context, value interface{}) (string, error) {
+	value = indirect(value)
+	if value == nil {
+		return "", nilValueError(ctx)
+	}
 	switch v := value.(type) {
 	case string:
 		return v, nil
@@ -45,22 +57,26 @@ func ParseString(ctx context.Context, value interface{}) (string, error) {
 		return strconv.FormatUint(v, 10), nil
 	case fmt.Stringer:
 		return v.String(), nil
+	case encoding.TextMarshaler:
+		text, err := v.MarshalText()
+		if err != nil {
+			return "", err
+		}
+		return string(text), nil
 	default:
-		if isSubtypeOfString(value) {
-			return ParseString(ctx, fmt.Sprintf("%s", value))
+		if basic, ok := toBasicKind(value); ok {
+			return parseString(ctx, basic)
 		}
-		return "", errors.Wrapf(ctx, ErrInvalidType, "parse failed")
+		return "", errUnsupportedType(ctx, value)
 	}
 }
 
-func isSubtypeOfString(value interface{}) bool {
-	t := reflect.TypeOf(value)
-	return t != nil && t.Kind() == reflect.String
-}
-
 // ParseStringDefault converts an interface{} value to a string, returning defaultValue on error.
 // This is a convenience wrapper around ParseString that never returns an error.
 func ParseStringDefault(ctx context.Context, value interface{}, defaultValue string) string {
+	if isNilDefault(ctx, value) {
+		return defaultValue
+	}
 	result, err := ParseString(ctx, value)
 	if err != nil {
 		return defaultValue
@@ -41,6 +41,8 @@ var _ = DescribeTable("ParseString",
 	Entry("uint", uint(42), "42", false),
 	Entry("uint32", uint32(42), "42", false),
 	Entry("uint64", uint64(42), "42", false),
+	Entry("custom int", MyInt(42), "42", false),
+	Entry("custom bool", MyBool(true), "true", false),
 	Entry("struct", struct{}{}, "", true),
 	Entry("[]string", []string{"banana"}, "", true),
 	Entry("map[string]string", map[string]string{"key": "banana"}, "", true),
@@ -26,13 +26,31 @@ type HasString interface {
 // Supported types: []string, []interface{}, []float64, []bool, []int, []int32, []int64, string,
 // HasStrings interface, HasString interface, slices of string subtypes (e.g., []Direction where type Direction string),
 // and slices of types implementing String() string method.
-// A single string value is returned as a slice with one element.
-// Returns nil for nil input.
-// Returns an error if the value cannot be converted to []string.
+// A single string value is returned as a slice with one element, decoded if it holds a JSON
+// array and WithJSON is set in ctx, or split with Split if WithSplit is set in ctx.
+// Returns nil for nil input unless a NilPolicy is configured with WithNilPolicy.
+// Returns a *ParseError if the value cannot be converted to []string,
+// or ParseErrors with all failing elements if WithCollectErrors is set.
 func ParseStrings(ctx context.Context, value interface{}) ([]string, error) {
+	result, err := parseStrings(ctx, value)
+	if err != nil {
+		if isParseErrors(err) {
+			return nil, err
+		}
+		return nil, newParseError(value, "[]string", err)
+	}
+	return result, nil
+}
+
+func parseStrings(ctx context.Context, value interface{}) ([]string, error) {
+	value = indirect(value)
+	if value == nil {
+		if _, ok := nilPolicyFromContext(ctx); !ok {
+			return nil, nil
+		}
+		return nil, nilValueError(ctx)
+	}
 	switch v := value.(type) {
-	case nil:
-		return nil, nil
 	case []string:
 		return v, nil
 	case []interface{}:
@@ -48,11 +66,14 @@ func ParseStrings(ctx context.Context, value interface{}) ([]string, error) {
 	case []int64:
 		return toStringList(ctx, v)
 	case string:
-		str, err := ParseString(ctx, v)
+		expanded, err := expandString(ctx, v)
 		if err != nil {
 			return nil, err
 		}
-		return []string{str}, nil
+		if _, ok := expanded.(string); ok {
+			return []string{v}, nil
+		}
+		return parseStrings(ctx, expanded)
 	case HasStrings:
 		return v.Strings(), nil
 	case HasString:
@@ -64,25 +85,35 @@ func ParseStrings(ctx context.Context, value interface{}) ([]string, error) {
 		if isSliceOfHasString(value) {
 			return convertSliceToStrings(ctx, value)
 		}
-		return nil, errors.Errorf(ctx, "unsupported type %T", value)
+		return nil, errUnsupportedType(ctx, value)
 	}
 }
 
 func toStringList[T any](ctx context.Context, input []T) ([]string, error) {
 	result := make([]string, len(input))
+	errs := newErrorCollector(ctx)
 	for i, a := range input {
 		str, err := ParseString(ctx, a)
 		if err != nil {
-			return nil, err
+			if !errs.add(err, indexPath(i)) {
+				break
+			}
+			continue
 		}
 		result[i] = str
 	}
+	if err := errs.err(); err != nil {
+		return nil, err
+	}
 	return result, nil
 }
 
 // ParseStringsDefault converts an interface{} value to a string slice, returning defaultValue on error.
 // This is a convenience wrapper around ParseStrings that never returns an error.
 func ParseStringsDefault(ctx context.Context, value interface{}, defaultValue []string) []string {
+	if isNilDefault(ctx, value) {
+		return defaultValue
+	}
 	result, err := ParseStrings(ctx, value)
 	if err != nil {
 		return defaultValue
@@ -153,14 +184,21 @@ func convertSliceToStrings(ctx context.Context, value interface{}) ([]string, er
 	length := v.Len()
 	result := make([]string, length)
 
+	errs := newErrorCollector(ctx)
 	for i := 0; i < length; i++ {
 		elem := v.Index(i).Interface()
 		str, err := ParseString(ctx, elem)
 		if err != nil {
-			return nil, errors.Wrapf(ctx, err, "failed to convert element %d", i)
+			if !errs.add(err, indexPath(i)) {
+				break
+			}
+			continue
 		}
 		result[i] = str
 	}
+	if err := errs.err(); err != nil {
+		return nil, err
+	}
 
 	return result, nil
 }
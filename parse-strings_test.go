@@ -34,6 +34,15 @@ func (d DirectionsWithStrings) Strings() []string {
 	return d.vals
 }
 
+// Test type implementing Strings() []string with a pointer receiver
+type DirectionsWithPointerStrings struct {
+	vals []string
+}
+
+func (d *DirectionsWithPointerStrings) Strings() []string {
+	return d.vals
+}
+
 var _ = DescribeTable(
 	"ParseStrings",
 	func(value interface{}, expectedResult []string, expectError bool) {
@@ -78,6 +87,12 @@ var _ = DescribeTable(
 		[]string{},
 		false,
 	),
+	Entry(
+		"HasStrings interface with pointer receiver",
+		&DirectionsWithPointerStrings{vals: []string{"east", "west"}},
+		[]string{"east", "west"},
+		false,
+	),
 	// HasString interface tests (single value converted to slice)
 	Entry(
 		"HasString interface",
@@ -0,0 +1,44 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse
+
+import (
+	"context"
+	"encoding"
+	"fmt"
+
+	"github.com/bborbe/errors"
+)
+
+// ParseTextUnmarshaler converts an interface{} value to text using ParseString and passes it
+// to target.UnmarshalText.
+// This allows parsing into any type implementing encoding.TextUnmarshaler such as net.IP,
+// netip.Addr, big.Int or custom enums.
+// Returns a *ParseError if the value cannot be converted to a string or UnmarshalText fails.
+func ParseTextUnmarshaler(
+	ctx context.Context,
+	value interface{},
+	target encoding.TextUnmarshaler,
+) error {
+	targetType := fmt.Sprintf("%T", target)
+	if indirect(value) == nil {
+		if err := nilValueError(ctx); err != nil {
+			return newParseError(value, targetType, err)
+		}
+		return nil
+	}
+	str, err := parseString(ctx, value)
+	if err != nil {
+		return newParseError(value, targetType, err)
+	}
+	if err := target.UnmarshalText([]byte(str)); err != nil {
+		return newParseError(
+			value,
+			targetType,
+			errors.Wrapf(ctx, ErrInvalidType, "unmarshal text '%s' failed: %v", str, err),
+		)
+	}
+	return nil
+}
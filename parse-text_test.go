@@ -0,0 +1,174 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse_test
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"math/big"
+	"net"
+	"net/netip"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/parse"
+)
+
+type Color int
+
+const (
+	ColorRed Color = iota + 1
+	ColorBlue
+)
+
+func (c Color) MarshalText() ([]byte, error) {
+	switch c {
+	case ColorRed:
+		return []byte("red"), nil
+	case ColorBlue:
+		return []byte("blue"), nil
+	default:
+		return nil, fmt.Errorf("unknown color %d", int(c))
+	}
+}
+
+func (c *Color) UnmarshalText(text []byte) error {
+	switch strings.ToLower(string(text)) {
+	case "red":
+		*c = ColorRed
+	case "blue":
+		*c = ColorBlue
+	default:
+		return fmt.Errorf("unknown color '%s'", text)
+	}
+	return nil
+}
+
+var _ = DescribeTable("ParseTextUnmarshaler",
+	func(value interface{}, expectedResult Color, expectError bool) {
+		var result Color
+		err := parse.ParseTextUnmarshaler(context.Background(), value, &result)
+		if expectError {
+			Expect(err).NotTo(BeNil())
+			Expect(stderrors.Is(err, parse.ErrInvalidType)).To(BeTrue())
+		} else {
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(expectedResult))
+		}
+	},
+	Entry("string", "red", ColorRed, false),
+	Entry("upper case string", "BLUE", ColorBlue, false),
+	Entry("nil", nil, Color(0), true),
+	Entry("unknown", "green", Color(0), true),
+	Entry("unsupported type", []int{1}, Color(0), true),
+)
+
+var _ = Describe("Parse with encoding.TextUnmarshaler targets", func() {
+	var ctx context.Context
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+	It("parses net.IP", func() {
+		result, err := parse.Parse[net.IP](ctx, "192.168.0.1")
+		Expect(err).To(BeNil())
+		Expect(result.String()).To(Equal("192.168.0.1"))
+	})
+	It("returns net.IP unchanged", func() {
+		ip := net.ParseIP("10.0.0.1")
+		result, err := parse.Parse[net.IP](ctx, ip)
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal(ip))
+	})
+	It("parses netip.Addr", func() {
+		result, err := parse.Parse[netip.Addr](ctx, "::1")
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal(netip.MustParseAddr("::1")))
+	})
+	It("returns error for invalid netip.Addr", func() {
+		_, err := parse.Parse[netip.Addr](ctx, "banana")
+		Expect(err).NotTo(BeNil())
+		Expect(stderrors.Is(err, parse.ErrInvalidType)).To(BeTrue())
+		var parseErr *parse.ParseError
+		Expect(stderrors.As(err, &parseErr)).To(BeTrue())
+		Expect(parseErr.TargetType).To(Equal("*netip.Addr"))
+	})
+	It("parses *big.Int", func() {
+		result, err := parse.Parse[*big.Int](ctx, "123456789012345678901234567890")
+		Expect(err).To(BeNil())
+		Expect(result.String()).To(Equal("123456789012345678901234567890"))
+	})
+	It("parses *big.Int from *big.Int", func() {
+		result, err := parse.Parse[*big.Int](ctx, big.NewInt(42))
+		Expect(err).To(BeNil())
+		Expect(result.Int64()).To(Equal(int64(42)))
+	})
+	It("parses nil to nil *big.Int", func() {
+		result, err := parse.Parse[*big.Int](ctx, nil)
+		Expect(err).To(BeNil())
+		Expect(result).To(BeNil())
+	})
+	It("parses custom enum from pointer", func() {
+		value := "blue"
+		result, err := parse.Parse[Color](ctx, &value)
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal(ColorBlue))
+	})
+	It("parses custom enum", func() {
+		result, err := parse.Parse[Color](ctx, "blue")
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal(ColorBlue))
+	})
+	It("parses pointer to int", func() {
+		result, err := parse.Parse[*int](ctx, "42")
+		Expect(err).To(BeNil())
+		Expect(*result).To(Equal(42))
+	})
+})
+
+var _ = Describe("ParseString with encoding.TextMarshaler", func() {
+	It("uses MarshalText", func() {
+		result, err := parse.ParseString(context.Background(), netip.MustParseAddr("10.0.0.1"))
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal("10.0.0.1"))
+	})
+	It("returns error if MarshalText fails", func() {
+		_, err := parse.ParseString(context.Background(), Color(7))
+		Expect(err).NotTo(BeNil())
+	})
+})
+
+var _ = DescribeTable("json.Number",
+	func(value json.Number, expectedInt64 int64, expectedUint64 uint64, expectedFloat64 float64) {
+		ctx := context.Background()
+		i, err := parse.ParseInt64(ctx, value)
+		Expect(err).To(BeNil())
+		Expect(i).To(Equal(expectedInt64))
+		u, err := parse.ParseUint64(ctx, value)
+		Expect(err).To(BeNil())
+		Expect(u).To(Equal(expectedUint64))
+		f, err := parse.ParseFloat64(ctx, value)
+		Expect(err).To(BeNil())
+		Expect(f).To(Equal(expectedFloat64))
+	},
+	Entry("small", json.Number("42"), int64(42), uint64(42), float64(42)),
+	Entry(
+		"max int64",
+		json.Number("9223372036854775807"),
+		int64(9223372036854775807),
+		uint64(9223372036854775807),
+		float64(9223372036854775807),
+	),
+	Entry(
+		"precision beyond float64",
+		json.Number("9007199254740993"),
+		int64(9007199254740993),
+		uint64(9007199254740993),
+		float64(9007199254740992),
+	),
+)
@@ -0,0 +1,96 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/bborbe/errors"
+)
+
+// DefaultTimeLayouts are the layouts ParseTimeAny tries if no layouts are given.
+// time.Parse accepts fractional seconds after the seconds field of any layout, so time.RFC3339
+// also matches values like "2023-12-25T10:30:00.123Z".
+var DefaultTimeLayouts = []string{
+	time.RFC3339,
+	time.DateOnly,
+	time.RFC1123Z,
+	time.RFC1123,
+	time.ANSIC,
+	time.DateTime,
+	"2006-01-02 15:04:05Z07:00",
+	"2006-01-02 15:04:05-07",
+}
+
+// ParseTimeAny converts an interface{} value to a time.Time using the first of layouts that
+// matches, or DefaultTimeLayouts if no layouts are given.
+// Returns the parsed time and the matching layout. time.Time values are returned unchanged
+// with an empty layout, pointers are dereferenced.
+// Other values are first converted to a string using ParseString. Times without offset are
+// interpreted in the location of WithLocation like in ParseTimeInLocation.
+// Returns a *ParseError listing every layout tried if no layout matches.
+func ParseTimeAny(
+	ctx context.Context,
+	value interface{},
+	layouts ...string,
+) (time.Time, string, error) {
+	switch v := indirect(value).(type) {
+	case nil:
+		if err := nilValueError(ctx); err != nil {
+			return time.Time{}, "", newParseError(value, "time.Time", err)
+		}
+		return time.Time{}, "", nil
+	case time.Time:
+		return v, "", nil
+	}
+	str, err := parseString(ctx, value)
+	if err != nil {
+		return time.Time{}, "", newParseError(value, "time.Time", err)
+	}
+	if len(layouts) == 0 {
+		layouts = DefaultTimeLayouts
+	}
+	for _, layout := range layouts {
+		t, err := parseTimeLayout(ctx, str, layout)
+		if err == nil {
+			return t, layout, nil
+		}
+		var timeParseErr *time.ParseError
+		if !errors.As(err, &timeParseErr) {
+			return time.Time{}, "", newParseError(value, "time.Time", err)
+		}
+	}
+	return time.Time{}, "", newParseError(
+		value,
+		"time.Time",
+		errors.Wrapf(
+			ctx,
+			ErrInvalidType,
+			"parse with layouts '%s' failed",
+			strings.Join(layouts, "', '"),
+		),
+	)
+}
+
+// ParseTimeAnyDefault converts an interface{} value to a time.Time using the first of layouts
+// that matches, returning defaultValue on error.
+// This is a convenience wrapper around ParseTimeAny that never returns an error.
+func ParseTimeAnyDefault(
+	ctx context.Context,
+	value interface{},
+	defaultValue time.Time,
+	layouts ...string,
+) time.Time {
+	if isNilDefault(ctx, value) {
+		return defaultValue
+	}
+	result, _, err := ParseTimeAny(ctx, value, layouts...)
+	if err != nil {
+		return defaultValue
+	}
+	return result
+}
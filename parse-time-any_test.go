@@ -0,0 +1,160 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse_test
+
+import (
+	"context"
+	stderrors "errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/parse"
+)
+
+var _ = DescribeTable("ParseTimeAny",
+	func(
+		value interface{},
+		layouts []string,
+		expectedResult time.Time,
+		expectedLayout string,
+		expectError bool,
+	) {
+		result, layout, err := parse.ParseTimeAny(context.Background(), value, layouts...)
+		if expectError {
+			Expect(err).NotTo(BeNil())
+			Expect(result).To(Equal(time.Time{}))
+			Expect(layout).To(BeEmpty())
+		} else {
+			Expect(err).To(BeNil())
+			Expect(result.Equal(expectedResult)).To(BeTrue())
+			Expect(layout).To(Equal(expectedLayout))
+		}
+	},
+	Entry(
+		"RFC3339",
+		"2023-12-25T10:30:00Z",
+		nil,
+		time.Date(2023, 12, 25, 10, 30, 0, 0, time.UTC),
+		time.RFC3339,
+		false,
+	),
+	Entry(
+		"RFC3339 with nanoseconds",
+		"2023-12-25T10:30:00.123456789+01:00",
+		nil,
+		time.Date(2023, 12, 25, 9, 30, 0, 123456789, time.UTC),
+		time.RFC3339,
+		false,
+	),
+	Entry(
+		"date only",
+		"2023-12-25",
+		nil,
+		time.Date(2023, 12, 25, 0, 0, 0, 0, time.UTC),
+		time.DateOnly,
+		false,
+	),
+	Entry(
+		"RFC1123",
+		"Mon, 25 Dec 2023 10:30:00 UTC",
+		nil,
+		time.Date(2023, 12, 25, 10, 30, 0, 0, time.UTC),
+		time.RFC1123,
+		false,
+	),
+	Entry(
+		"RFC1123Z",
+		"Mon, 25 Dec 2023 10:30:00 +0000",
+		nil,
+		time.Date(2023, 12, 25, 10, 30, 0, 0, time.UTC),
+		time.RFC1123Z,
+		false,
+	),
+	Entry(
+		"ANSIC",
+		"Mon Dec 25 10:30:00 2023",
+		nil,
+		time.Date(2023, 12, 25, 10, 30, 0, 0, time.UTC),
+		time.ANSIC,
+		false,
+	),
+	Entry(
+		"SQL datetime",
+		"2023-12-25 10:30:00",
+		nil,
+		time.Date(2023, 12, 25, 10, 30, 0, 0, time.UTC),
+		time.DateTime,
+		false,
+	),
+	Entry(
+		"SQL datetime with fraction",
+		"2023-12-25 10:30:00.123",
+		nil,
+		time.Date(2023, 12, 25, 10, 30, 0, 123000000, time.UTC),
+		time.DateTime,
+		false,
+	),
+	Entry(
+		"SQL datetime with zone",
+		"2023-12-25 10:30:00.5+01",
+		nil,
+		time.Date(2023, 12, 25, 9, 30, 0, 500000000, time.UTC),
+		"2006-01-02 15:04:05-07",
+		false,
+	),
+	Entry(
+		"custom layouts in order",
+		"25/12/2023",
+		[]string{"2006-01-02", "02/01/2006", "01/02/2006"},
+		time.Date(2023, 12, 25, 0, 0, 0, 0, time.UTC),
+		"02/01/2006",
+		false,
+	),
+	Entry(
+		"time.Time",
+		time.Date(2023, 12, 25, 0, 0, 0, 0, time.UTC),
+		nil,
+		time.Date(2023, 12, 25, 0, 0, 0, 0, time.UTC),
+		"",
+		false,
+	),
+	Entry("invalid", "christmas", nil, time.Time{}, "", true),
+	Entry("custom layouts no match", "2023-12-25", []string{time.RFC3339}, time.Time{}, "", true),
+	Entry("nil", nil, nil, time.Time{}, "", true),
+)
+
+var _ = Describe("ParseTimeAny", func() {
+	It("lists every layout tried", func() {
+		_, _, err := parse.ParseTimeAny(
+			context.Background(),
+			"christmas",
+			time.DateOnly,
+			time.RFC3339,
+		)
+		Expect(err).NotTo(BeNil())
+		Expect(err.Error()).To(ContainSubstring("'2006-01-02', '2006-01-02T15:04:05Z07:00'"))
+		Expect(stderrors.Is(err, parse.ErrInvalidType)).To(BeTrue())
+	})
+	It("lists the default layouts", func() {
+		_, _, err := parse.ParseTimeAny(context.Background(), "christmas")
+		Expect(err).NotTo(BeNil())
+		for _, layout := range parse.DefaultTimeLayouts {
+			Expect(err.Error()).To(ContainSubstring("'" + layout + "'"))
+		}
+	})
+})
+
+var _ = DescribeTable("ParseTimeAnyDefault",
+	func(value interface{}, expectedResult time.Time) {
+		defaultValue := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+		result := parse.ParseTimeAnyDefault(context.Background(), value, defaultValue)
+		Expect(result.Equal(expectedResult)).To(BeTrue())
+	},
+	Entry("valid", "2023-12-25", time.Date(2023, 12, 25, 0, 0, 0, 0, time.UTC)),
+	Entry("invalid", "christmas", time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)),
+	Entry("nil", nil, time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)),
+)
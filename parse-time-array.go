@@ -0,0 +1,30 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse
+
+import (
+	"context"
+	"time"
+)
+
+// ParseTimeArray converts an interface{} value to a time.Time slice using the specified format.
+// Accepts any slice or array, e.g. []interface{}, []string or []time.Time.
+// Each element is converted using ParseTime, see ParseSlice.
+// Returns a *ParseError if the value cannot be converted to []time.Time.
+func ParseTimeArray(ctx context.Context, value interface{}, format string) ([]time.Time, error) {
+	return ParseSlice[time.Time](WithTimeLayout(ctx, format), value)
+}
+
+// ParseTimeArrayDefault converts an interface{} value to a time.Time slice using the specified
+// format, returning defaultValue on error.
+// This is a convenience wrapper around ParseTimeArray that never returns an error.
+func ParseTimeArrayDefault(
+	ctx context.Context,
+	value interface{},
+	format string,
+	defaultValue []time.Time,
+) []time.Time {
+	return ParseSliceDefault(WithTimeLayout(ctx, format), value, defaultValue)
+}
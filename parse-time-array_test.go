@@ -0,0 +1,65 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/parse"
+)
+
+var _ = DescribeTable("ParseTimeArray",
+	func(input interface{}, format string, expectedResult []time.Time, hasError bool) {
+		result, err := parse.ParseTimeArray(context.Background(), input, format)
+		Expect(result).To(Equal(expectedResult))
+		if hasError {
+			Expect(err).NotTo(BeNil())
+		} else {
+			Expect(err).To(BeNil())
+		}
+	},
+	Entry(
+		"[]string",
+		[]string{"2023-12-25", "2024-01-01"},
+		"2006-01-02",
+		[]time.Time{
+			time.Date(2023, 12, 25, 0, 0, 0, 0, time.UTC),
+			time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		false,
+	),
+	Entry(
+		"[]time.Time",
+		[]time.Time{time.Date(2023, 12, 25, 0, 0, 0, 0, time.UTC)},
+		"2006-01-02",
+		[]time.Time{time.Date(2023, 12, 25, 0, 0, 0, 0, time.UTC)},
+		false,
+	),
+	Entry("wrong format", []string{"2023-12-25"}, time.RFC3339, nil, true),
+	Entry("not a slice", "2023-12-25", "2006-01-02", nil, true),
+)
+
+var _ = DescribeTable("ParseTimeArrayDefault",
+	func(input interface{}, defaultValue []time.Time, expectedResult []time.Time) {
+		result := parse.ParseTimeArrayDefault(
+			context.Background(),
+			input,
+			"2006-01-02",
+			defaultValue,
+		)
+		Expect(result).To(Equal(expectedResult))
+	},
+	Entry(
+		"valid",
+		[]string{"2023-12-25"},
+		nil,
+		[]time.Time{time.Date(2023, 12, 25, 0, 0, 0, 0, time.UTC)},
+	),
+	Entry("invalid", []string{"x"}, []time.Time{}, []time.Time{}),
+)
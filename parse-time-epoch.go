@@ -0,0 +1,216 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// EpochUnit defines the unit of a Unix epoch parsed by ParseTimeEpoch.
+type EpochUnit int
+
+const (
+	// EpochSeconds reads the value as seconds since the Unix epoch. This is the default.
+	EpochSeconds EpochUnit = iota
+	// EpochMilliseconds reads the value as milliseconds since the Unix epoch.
+	EpochMilliseconds
+	// EpochMicroseconds reads the value as microseconds since the Unix epoch.
+	EpochMicroseconds
+	// EpochNanoseconds reads the value as nanoseconds since the Unix epoch.
+	EpochNanoseconds
+	// EpochAuto infers the unit from the magnitude of the value: below 1e11 are seconds
+	// (until year 5138), below 1e14 milliseconds, below 1e17 microseconds, all others
+	// nanoseconds.
+	EpochAuto
+)
+
+type epochUnitContextKey struct{}
+
+// WithEpochUnit returns a copy of ctx that makes ParseTime, Parse and Decode accept Unix epochs
+// in unit for time.Time values, e.g. the epoch millis of an event.
+func WithEpochUnit(ctx context.Context, unit EpochUnit) context.Context {
+	return context.WithValue(ctx, epochUnitContextKey{}, unit)
+}
+
+// EpochUnitFromContext returns the EpochUnit stored in ctx and whether one is set.
+func EpochUnitFromContext(ctx context.Context) (EpochUnit, bool) {
+	unit, ok := ctx.Value(epochUnitContextKey{}).(EpochUnit)
+	return unit, ok
+}
+
+// nanoseconds returns the number of nanoseconds per unit.
+func (u EpochUnit) nanoseconds() int64 {
+	switch u {
+	case EpochMilliseconds:
+		return int64(time.Millisecond)
+	case EpochMicroseconds:
+		return int64(time.Microsecond)
+	case EpochNanoseconds:
+		return int64(time.Nanosecond)
+	default:
+		return int64(time.Second)
+	}
+}
+
+// ParseTimeEpoch converts an interface{} Unix epoch in unit to a time.Time in UTC.
+// Supported types: all integer and float types, json.Number and numeric strings.
+// Floats keep their fraction, e.g. 1700000000.5 seconds, and are rounded to nanoseconds.
+// time.Time values are returned unchanged, pointers are dereferenced.
+// Returns a *ParseError if the value is no number and a *ParseError matching ErrOutOfRange if
+// it exceeds the range of time.Time.
+func ParseTimeEpoch(ctx context.Context, value interface{}, unit EpochUnit) (time.Time, error) {
+	switch v := indirect(value).(type) {
+	case nil:
+		if err := nilValueError(ctx); err != nil {
+			return time.Time{}, newParseError(value, "time.Time", err)
+		}
+		return time.Time{}, nil
+	case time.Time:
+		return v, nil
+	}
+	if isFloatEpoch(value) {
+		f, err := ParseFloat64(ctx, value)
+		if err != nil {
+			return time.Time{}, newParseError(value, "time.Time", err)
+		}
+		return floatEpoch(value, f, unit)
+	}
+	i, err := ParseInt64(ctx, value)
+	if err != nil {
+		return time.Time{}, newParseError(value, "time.Time", err)
+	}
+	return intEpoch(value, i, detectEpochUnit(math.Abs(float64(i)), unit))
+}
+
+// ParseTimeEpochDefault converts an interface{} Unix epoch in unit to a time.Time in UTC,
+// returning defaultValue on error.
+// This is a convenience wrapper around ParseTimeEpoch that never returns an error.
+func ParseTimeEpochDefault(
+	ctx context.Context,
+	value interface{},
+	unit EpochUnit,
+	defaultValue time.Time,
+) time.Time {
+	if isNilDefault(ctx, value) {
+		return defaultValue
+	}
+	result, err := ParseTimeEpoch(ctx, value, unit)
+	if err != nil {
+		return defaultValue
+	}
+	return result
+}
+
+// isEpochNumber reports whether value is an integer, float or json.Number.
+func isEpochNumber(value interface{}) bool {
+	v := indirect(value)
+	if _, ok := v.(json.Number); ok {
+		return true
+	}
+	switch reflect.ValueOf(v).Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+// isNumericString reports whether value is a decimal number like "1700000000" or "1.5".
+func isNumericString(value string) bool {
+	value = strings.TrimSpace(value)
+	if strings.Trim(value, "0123456789+-.eE") != "" {
+		return false
+	}
+	_, err := strconv.ParseFloat(value, 64)
+	return err == nil
+}
+
+// isFloatEpoch reports whether value is a float or a numeric string with a fraction or exponent.
+func isFloatEpoch(value interface{}) bool {
+	v := indirect(value)
+	if number, ok := v.(json.Number); ok {
+		return strings.ContainsAny(number.String(), ".eE")
+	}
+	switch reflect.ValueOf(v).Kind() {
+	case reflect.Float32, reflect.Float64:
+		return true
+	case reflect.String:
+		return strings.ContainsAny(reflect.ValueOf(v).String(), ".eE")
+	default:
+		return false
+	}
+}
+
+// detectEpochUnit returns the unit for EpochAuto based on magnitude, otherwise unit.
+func detectEpochUnit(magnitude float64, unit EpochUnit) EpochUnit {
+	if unit != EpochAuto {
+		return unit
+	}
+	switch {
+	case magnitude < 1e11:
+		return EpochSeconds
+	case magnitude < 1e14:
+		return EpochMilliseconds
+	case magnitude < 1e17:
+		return EpochMicroseconds
+	default:
+		return EpochNanoseconds
+	}
+}
+
+// minEpochSeconds and maxEpochSeconds bound the Unix seconds time.Time can represent, from
+// year -292277022399 to year 292277024627. time.Unix silently wraps outside of them.
+const (
+	minEpochSeconds = -9223372028715321600
+	maxEpochSeconds = math.MaxInt64 - 62135596800
+)
+
+// intEpoch converts i in unit to a time.Time in UTC and reports an *OverflowError if it is out
+// of the range of time.Time.
+func intEpoch(value interface{}, i int64, unit EpochUnit) (time.Time, error) {
+	switch unit {
+	case EpochMilliseconds:
+		return time.UnixMilli(i).UTC(), nil
+	case EpochMicroseconds:
+		return time.UnixMicro(i).UTC(), nil
+	case EpochNanoseconds:
+		return time.Unix(0, i).UTC(), nil
+	}
+	if i < minEpochSeconds || i > maxEpochSeconds {
+		return time.Time{}, newParseError(
+			value,
+			"time.Time",
+			&OverflowError{Value: value, TargetType: "time.Time"},
+		)
+	}
+	return time.Unix(i, 0).UTC(), nil
+}
+
+// floatEpoch converts f in unit to a time.Time in UTC, keeping the fraction.
+func floatEpoch(value interface{}, f float64, unit EpochUnit) (time.Time, error) {
+	whole := math.Floor(f)
+	if math.IsNaN(f) || whole < math.MinInt64 || whole >= math.MaxInt64 {
+		return time.Time{}, newParseError(
+			value,
+			"time.Time",
+			&OverflowError{Value: value, TargetType: "time.Time"},
+		)
+	}
+	unit = detectEpochUnit(math.Abs(f), unit)
+	fraction := time.Duration(math.Round((f - whole) * float64(unit.nanoseconds())))
+	result, err := intEpoch(value, int64(whole), unit)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return result.Add(fraction), nil
+}
@@ -0,0 +1,239 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse_test
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"math"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/parse"
+)
+
+var _ = DescribeTable("ParseTimeEpoch",
+	func(value interface{}, unit parse.EpochUnit, expectedResult time.Time, expectError bool) {
+		result, err := parse.ParseTimeEpoch(context.Background(), value, unit)
+		if expectError {
+			Expect(err).NotTo(BeNil())
+			Expect(result).To(Equal(time.Time{}))
+		} else {
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(expectedResult))
+		}
+	},
+	Entry(
+		"int seconds",
+		1700000000,
+		parse.EpochSeconds,
+		time.Date(2023, 11, 14, 22, 13, 20, 0, time.UTC),
+		false,
+	),
+	Entry(
+		"int64 milliseconds",
+		int64(1700000000123),
+		parse.EpochMilliseconds,
+		time.Date(2023, 11, 14, 22, 13, 20, 123000000, time.UTC),
+		false,
+	),
+	Entry(
+		"string microseconds",
+		"1700000000123456",
+		parse.EpochMicroseconds,
+		time.Date(2023, 11, 14, 22, 13, 20, 123456000, time.UTC),
+		false,
+	),
+	Entry(
+		"json.Number nanoseconds",
+		json.Number("1700000000123456789"),
+		parse.EpochNanoseconds,
+		time.Date(2023, 11, 14, 22, 13, 20, 123456789, time.UTC),
+		false,
+	),
+	Entry(
+		"float64 seconds",
+		1700000000.5,
+		parse.EpochSeconds,
+		time.Date(2023, 11, 14, 22, 13, 20, 500000000, time.UTC),
+		false,
+	),
+	Entry(
+		"float string milliseconds",
+		"1700000000123.5",
+		parse.EpochMilliseconds,
+		time.Date(2023, 11, 14, 22, 13, 20, 123500000, time.UTC),
+		false,
+	),
+	Entry(
+		"json.Number float seconds",
+		json.Number("1700000000.25"),
+		parse.EpochSeconds,
+		time.Date(2023, 11, 14, 22, 13, 20, 250000000, time.UTC),
+		false,
+	),
+	Entry(
+		"negative seconds",
+		-86400,
+		parse.EpochSeconds,
+		time.Date(1969, 12, 31, 0, 0, 0, 0, time.UTC),
+		false,
+	),
+	Entry(
+		"auto seconds",
+		1700000000,
+		parse.EpochAuto,
+		time.Date(2023, 11, 14, 22, 13, 20, 0, time.UTC),
+		false,
+	),
+	Entry(
+		"auto milliseconds",
+		int64(1700000000123),
+		parse.EpochAuto,
+		time.Date(2023, 11, 14, 22, 13, 20, 123000000, time.UTC),
+		false,
+	),
+	Entry(
+		"auto microseconds",
+		"1700000000123456",
+		parse.EpochAuto,
+		time.Date(2023, 11, 14, 22, 13, 20, 123456000, time.UTC),
+		false,
+	),
+	Entry(
+		"auto nanoseconds",
+		int64(1700000000123456789),
+		parse.EpochAuto,
+		time.Date(2023, 11, 14, 22, 13, 20, 123456789, time.UTC),
+		false,
+	),
+	Entry(
+		"auto float seconds",
+		1700000000.5,
+		parse.EpochAuto,
+		time.Date(2023, 11, 14, 22, 13, 20, 500000000, time.UTC),
+		false,
+	),
+	Entry(
+		"time.Time",
+		time.Date(2023, 11, 14, 0, 0, 0, 0, time.UTC),
+		parse.EpochSeconds,
+		time.Date(2023, 11, 14, 0, 0, 0, 0, time.UTC),
+		false,
+	),
+	Entry("invalid string", "yesterday", parse.EpochSeconds, time.Time{}, true),
+	Entry("infinite", math.Inf(1), parse.EpochSeconds, time.Time{}, true),
+	Entry("bool", true, parse.EpochSeconds, time.Time{}, true),
+	Entry("nil", nil, parse.EpochSeconds, time.Time{}, true),
+)
+
+var _ = Describe("ParseTimeEpoch", func() {
+	DescribeTable("returns ErrOutOfRange beyond the range of time.Time",
+		func(value interface{}, unit parse.EpochUnit) {
+			result, err := parse.ParseTimeEpoch(context.Background(), value, unit)
+			Expect(err).NotTo(BeNil())
+			Expect(stderrors.Is(err, parse.ErrOutOfRange)).To(BeTrue())
+			var overflowErr *parse.OverflowError
+			Expect(stderrors.As(err, &overflowErr)).To(BeTrue())
+			Expect(overflowErr.TargetType).To(Equal("time.Time"))
+			Expect(result).To(Equal(time.Time{}))
+		},
+		Entry("MaxInt64 seconds", int64(math.MaxInt64), parse.EpochSeconds),
+		Entry("MinInt64 seconds", int64(math.MinInt64), parse.EpochSeconds),
+		Entry("MaxInt64 seconds string", "9223372036854775807", parse.EpochSeconds),
+		Entry("float seconds", 1e19, parse.EpochSeconds),
+		Entry("negative float seconds", -9.22337203e18, parse.EpochSeconds),
+		Entry("float beyond int64", 1e30, parse.EpochSeconds),
+	)
+	It("accepts the largest seconds time.Time can represent", func() {
+		value := int64(math.MaxInt64 - 62135596800)
+		result, err := parse.ParseTimeEpoch(context.Background(), value, parse.EpochSeconds)
+		Expect(err).To(BeNil())
+		Expect(result.Unix()).To(Equal(value))
+		Expect(result.Year()).To(Equal(292277024627))
+	})
+	It("accepts MaxInt64 milliseconds", func() {
+		result, err := parse.ParseTimeEpoch(
+			context.Background(),
+			int64(math.MaxInt64),
+			parse.EpochMilliseconds,
+		)
+		Expect(err).To(BeNil())
+		Expect(result.UnixMilli()).To(Equal(int64(math.MaxInt64)))
+	})
+})
+
+var _ = Describe("WithEpochUnit", func() {
+	var ctx context.Context
+	BeforeEach(func() {
+		ctx = parse.WithEpochUnit(context.Background(), parse.EpochMilliseconds)
+	})
+	DescribeTable("makes ParseTime accept epochs",
+		func(value interface{}, expectedResult time.Time) {
+			result, err := parse.ParseTime(ctx, value, time.RFC3339)
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(expectedResult))
+		},
+		Entry(
+			"int64",
+			int64(1700000000123),
+			time.Date(2023, 11, 14, 22, 13, 20, 123000000, time.UTC),
+		),
+		Entry(
+			"json.Number",
+			json.Number("1700000000123"),
+			time.Date(2023, 11, 14, 22, 13, 20, 123000000, time.UTC),
+		),
+		Entry(
+			"numeric string",
+			"1700000000123",
+			time.Date(2023, 11, 14, 22, 13, 20, 123000000, time.UTC),
+		),
+		Entry(
+			"layout string",
+			"2023-11-14T22:13:20Z",
+			time.Date(2023, 11, 14, 22, 13, 20, 0, time.UTC),
+		),
+	)
+	It("keeps the layout for numeric strings matching it", func() {
+		result, err := parse.ParseTime(ctx, "2023", "2006")
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)))
+	})
+	It("is used by Parse", func() {
+		result, err := parse.Parse[time.Time](ctx, int64(1700000000123))
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal(time.Date(2023, 11, 14, 22, 13, 20, 123000000, time.UTC)))
+	})
+	It("returns error for invalid strings", func() {
+		_, err := parse.ParseTime(ctx, "Inf", time.RFC3339)
+		Expect(err).NotTo(BeNil())
+	})
+	It("is not set by default", func() {
+		_, ok := parse.EpochUnitFromContext(context.Background())
+		Expect(ok).To(BeFalse())
+		_, err := parse.ParseTime(context.Background(), int64(1700000000), time.RFC3339)
+		Expect(err).NotTo(BeNil())
+	})
+})
+
+var _ = DescribeTable("ParseTimeEpochDefault",
+	func(value interface{}, expectedResult time.Time) {
+		defaultValue := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+		result := parse.ParseTimeEpochDefault(
+			context.Background(),
+			value,
+			parse.EpochAuto,
+			defaultValue,
+		)
+		Expect(result).To(Equal(expectedResult))
+	},
+	Entry("valid", 1700000000, time.Date(2023, 11, 14, 22, 13, 20, 0, time.UTC)),
+	Entry("invalid", "yesterday", time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)),
+	Entry("nil", nil, time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)),
+)
@@ -0,0 +1,188 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse
+
+import (
+	"context"
+	"time"
+
+	"github.com/bborbe/errors"
+)
+
+// AmbiguousTimePolicy defines how ParseTimeInLocation resolves wall-clock times that occur
+// twice (DST overlap) or not at all (DST gap) in a location.
+type AmbiguousTimePolicy int
+
+const (
+	// AmbiguousEarlier picks the earlier instant of an overlap. This is the default.
+	AmbiguousEarlier AmbiguousTimePolicy = iota
+	// AmbiguousLater picks the later instant of an overlap.
+	AmbiguousLater
+	// AmbiguousAsError returns an error wrapping ErrInvalidType for overlaps and gaps.
+	AmbiguousAsError
+)
+
+type locationContextKey struct{}
+
+type ambiguousTimePolicyContextKey struct{}
+
+// WithLocation returns a copy of ctx that makes ParseTime, ParseTimeInLocation with a nil
+// location and all parsers built on them interpret times without offset in loc.
+func WithLocation(ctx context.Context, loc *time.Location) context.Context {
+	return context.WithValue(ctx, locationContextKey{}, loc)
+}
+
+// LocationFromContext returns the location stored in ctx and whether one is set.
+func LocationFromContext(ctx context.Context) (*time.Location, bool) {
+	loc, ok := ctx.Value(locationContextKey{}).(*time.Location)
+	return loc, ok && loc != nil
+}
+
+// WithAmbiguousTimePolicy returns a copy of ctx that makes ParseTimeInLocation resolve DST
+// overlaps and gaps according to policy.
+func WithAmbiguousTimePolicy(ctx context.Context, policy AmbiguousTimePolicy) context.Context {
+	return context.WithValue(ctx, ambiguousTimePolicyContextKey{}, policy)
+}
+
+// AmbiguousTimePolicyFromContext returns the AmbiguousTimePolicy stored in ctx or
+// AmbiguousEarlier.
+func AmbiguousTimePolicyFromContext(ctx context.Context) AmbiguousTimePolicy {
+	policy, _ := ctx.Value(ambiguousTimePolicyContextKey{}).(AmbiguousTimePolicy)
+	return policy
+}
+
+// ParseTimeInLocation converts an interface{} value to a time.Time using the specified format,
+// interpreting times without offset as wall-clock time in loc, or in the location of
+// WithLocation if loc is nil, or UTC if neither is set.
+// Times with an offset or zone in the value keep it. Wall-clock times occurring twice due to a
+// DST overlap are resolved with the AmbiguousTimePolicy of WithAmbiguousTimePolicy, times in a
+// DST gap are moved forward by the length of the gap, e.g. 02:30 becomes 03:30.
+// time.Time values are converted to loc, pointers are dereferenced.
+// Returns a *ParseError if the value cannot be converted or the time is ambiguous or
+// nonexistent and AmbiguousAsError is set.
+func ParseTimeInLocation(
+	ctx context.Context,
+	value interface{},
+	format string,
+	loc *time.Location,
+) (time.Time, error) {
+	if loc == nil {
+		var ok bool
+		if loc, ok = LocationFromContext(ctx); !ok {
+			loc = time.UTC
+		}
+	}
+	switch v := indirect(value).(type) {
+	case nil:
+		if err := nilValueError(ctx); err != nil {
+			return time.Time{}, newParseError(value, "time.Time", err)
+		}
+		return time.Time{}, nil
+	case time.Time:
+		return v.In(loc), nil
+	}
+	str, err := parseString(ctx, value)
+	if err != nil {
+		return time.Time{}, newParseError(value, "time.Time", err)
+	}
+	t, err := parseTimeInLocation(ctx, str, format, loc)
+	if err != nil {
+		return time.Time{}, newParseError(value, "time.Time", err)
+	}
+	return t, nil
+}
+
+// ParseTimeInLocationDefault converts an interface{} value to a time.Time using the specified
+// format and location, returning defaultValue on error.
+// This is a convenience wrapper around ParseTimeInLocation that never returns an error.
+func ParseTimeInLocationDefault(
+	ctx context.Context,
+	value interface{},
+	format string,
+	loc *time.Location,
+	defaultValue time.Time,
+) time.Time {
+	if isNilDefault(ctx, value) {
+		return defaultValue
+	}
+	result, err := ParseTimeInLocation(ctx, value, format, loc)
+	if err != nil {
+		return defaultValue
+	}
+	return result
+}
+
+// parseTimeInLocation parses value with format and resolves wall-clock times in loc.
+func parseTimeInLocation(
+	ctx context.Context,
+	value string,
+	format string,
+	loc *time.Location,
+) (time.Time, error) {
+	wall, err := time.Parse(format, value)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(ctx, err, "parse with format '%s' failed", format)
+	}
+	// Parsing again in a zone one second east of UTC shifts the instant only if value has no
+	// offset or zone of its own.
+	shifted, err := time.ParseInLocation(format, value, time.FixedZone("", 1))
+	if err != nil || shifted.Equal(wall) {
+		return wall, nil
+	}
+	return resolveWallClock(ctx, wall, loc)
+}
+
+// resolveWallClock returns the instant in loc whose wall clock equals the UTC fields of wall.
+func resolveWallClock(ctx context.Context, wall time.Time, loc *time.Location) (time.Time, error) {
+	// Real zones change their offset at most once within two days, so the offsets a day before
+	// and after cover both sides of a transition.
+	_, before := wall.Add(-24 * time.Hour).In(loc).Zone()
+	_, after := wall.Add(24 * time.Hour).In(loc).Zone()
+	early := wall.Add(-time.Duration(before) * time.Second).In(loc)
+	late := wall.Add(-time.Duration(after) * time.Second).In(loc)
+	if early.After(late) {
+		early, late = late, early
+	}
+	earlyValid, lateValid := sameWallClock(early, wall), sameWallClock(late, wall)
+	policy := AmbiguousTimePolicyFromContext(ctx)
+	switch {
+	case earlyValid && lateValid && !early.Equal(late):
+		switch policy {
+		case AmbiguousLater:
+			return late, nil
+		case AmbiguousAsError:
+			return time.Time{}, errors.Wrapf(
+				ctx,
+				ErrInvalidType,
+				"time '%s' is ambiguous in location '%s'",
+				wall.Format(time.DateTime),
+				loc,
+			)
+		default:
+			return early, nil
+		}
+	case earlyValid:
+		return early, nil
+	case lateValid:
+		return late, nil
+	case policy == AmbiguousAsError:
+		return time.Time{}, errors.Wrapf(
+			ctx,
+			ErrInvalidType,
+			"time '%s' does not exist in location '%s'",
+			wall.Format(time.DateTime),
+			loc,
+		)
+	default:
+		return wall.Add(-time.Duration(before) * time.Second).In(loc), nil
+	}
+}
+
+// sameWallClock reports whether t shows the same date and time as the UTC fields of wall.
+func sameWallClock(t time.Time, wall time.Time) bool {
+	return time.Date(
+		t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC,
+	).Equal(wall)
+}
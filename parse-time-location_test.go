@@ -0,0 +1,166 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse_test
+
+import (
+	"context"
+	stderrors "errors"
+	"time"
+	_ "time/tzdata"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/parse"
+)
+
+var _ = Describe("ParseTimeInLocation", func() {
+	var berlin *time.Location
+	BeforeEach(func() {
+		var err error
+		berlin, err = time.LoadLocation("Europe/Berlin")
+		Expect(err).To(BeNil())
+	})
+	DescribeTable("resolves wall-clock times",
+		func(
+			policy parse.AmbiguousTimePolicy,
+			value string,
+			expectedResult time.Time,
+			expectError bool,
+		) {
+			ctx := parse.WithAmbiguousTimePolicy(context.Background(), policy)
+			result, err := parse.ParseTimeInLocation(ctx, value, time.DateTime, berlin)
+			if expectError {
+				Expect(err).NotTo(BeNil())
+				Expect(stderrors.Is(err, parse.ErrInvalidType)).To(BeTrue())
+				Expect(result).To(Equal(time.Time{}))
+			} else {
+				Expect(err).To(BeNil())
+				Expect(result.UTC()).To(Equal(expectedResult))
+				Expect(result.Location()).To(Equal(berlin))
+			}
+		},
+		Entry(
+			"winter",
+			parse.AmbiguousEarlier,
+			"2024-01-15 10:00:00",
+			time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC),
+			false,
+		),
+		Entry(
+			"summer",
+			parse.AmbiguousEarlier,
+			"2024-07-15 10:00:00",
+			time.Date(2024, 7, 15, 8, 0, 0, 0, time.UTC),
+			false,
+		),
+		Entry(
+			"overlap earlier",
+			parse.AmbiguousEarlier,
+			"2024-10-27 02:30:00",
+			time.Date(2024, 10, 27, 0, 30, 0, 0, time.UTC),
+			false,
+		),
+		Entry(
+			"overlap later",
+			parse.AmbiguousLater,
+			"2024-10-27 02:30:00",
+			time.Date(2024, 10, 27, 1, 30, 0, 0, time.UTC),
+			false,
+		),
+		Entry("overlap error", parse.AmbiguousAsError, "2024-10-27 02:30:00", time.Time{}, true),
+		Entry(
+			"gap moves forward",
+			parse.AmbiguousEarlier,
+			"2024-03-31 02:30:00",
+			time.Date(2024, 3, 31, 1, 30, 0, 0, time.UTC),
+			false,
+		),
+		Entry(
+			"gap moves forward with later",
+			parse.AmbiguousLater,
+			"2024-03-31 02:30:00",
+			time.Date(2024, 3, 31, 1, 30, 0, 0, time.UTC),
+			false,
+		),
+		Entry("gap error", parse.AmbiguousAsError, "2024-03-31 02:30:00", time.Time{}, true),
+		Entry(
+			"after gap",
+			parse.AmbiguousAsError,
+			"2024-03-31 03:00:00",
+			time.Date(2024, 3, 31, 1, 0, 0, 0, time.UTC),
+			false,
+		),
+		Entry("invalid", parse.AmbiguousEarlier, "yesterday", time.Time{}, true),
+	)
+	It("keeps an offset of the value", func() {
+		ctx := parse.WithAmbiguousTimePolicy(context.Background(), parse.AmbiguousAsError)
+		result, err := parse.ParseTimeInLocation(
+			ctx,
+			"2024-10-27T02:30:00+01:00",
+			time.RFC3339,
+			berlin,
+		)
+		Expect(err).To(BeNil())
+		Expect(result.UTC()).To(Equal(time.Date(2024, 10, 27, 1, 30, 0, 0, time.UTC)))
+	})
+	It("uses the location of the context if loc is nil", func() {
+		ctx := parse.WithLocation(context.Background(), berlin)
+		result, err := parse.ParseTimeInLocation(ctx, "2024-01-15", time.DateOnly, nil)
+		Expect(err).To(BeNil())
+		Expect(result.UTC()).To(Equal(time.Date(2024, 1, 14, 23, 0, 0, 0, time.UTC)))
+	})
+	It("uses UTC without location", func() {
+		result, err := parse.ParseTimeInLocation(
+			context.Background(),
+			"2024-01-15",
+			time.DateOnly,
+			nil,
+		)
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal(time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)))
+	})
+	It("converts time.Time values", func() {
+		value := time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC)
+		result, err := parse.ParseTimeInLocation(context.Background(), value, time.DateOnly, berlin)
+		Expect(err).To(BeNil())
+		Expect(result.Equal(value)).To(BeTrue())
+		Expect(result.Location()).To(Equal(berlin))
+	})
+	It("makes ParseTime use the location of the context", func() {
+		ctx := parse.WithLocation(context.Background(), berlin)
+		result, err := parse.ParseTime(ctx, "2024-07-15 10:00:00", time.DateTime)
+		Expect(err).To(BeNil())
+		Expect(result.UTC()).To(Equal(time.Date(2024, 7, 15, 8, 0, 0, 0, time.UTC)))
+	})
+	It("makes ParseTimeAny use the location of the context", func() {
+		ctx := parse.WithLocation(context.Background(), berlin)
+		result, layout, err := parse.ParseTimeAny(ctx, "2024-06-01 12:00:00")
+		Expect(err).To(BeNil())
+		Expect(layout).To(Equal(time.DateTime))
+		Expect(result.UTC()).To(Equal(time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)))
+		Expect(result.Location()).To(Equal(berlin))
+	})
+	It("returns ambiguity errors from ParseTimeAny", func() {
+		ctx := parse.WithAmbiguousTimePolicy(
+			parse.WithLocation(context.Background(), berlin),
+			parse.AmbiguousAsError,
+		)
+		_, _, err := parse.ParseTimeAny(ctx, "2024-10-27 02:30:00")
+		Expect(err).NotTo(BeNil())
+		Expect(err.Error()).To(ContainSubstring("ambiguous"))
+	})
+	It("returns defaultValue on error", func() {
+		defaultValue := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+		result := parse.ParseTimeInLocationDefault(
+			context.Background(),
+			"yesterday",
+			time.DateTime,
+			berlin,
+			defaultValue,
+		)
+		Expect(result).To(Equal(defaultValue))
+	})
+})
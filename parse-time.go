@@ -11,24 +11,67 @@ import (
 	"github.com/bborbe/errors"
 )
 
+type timeLayoutContextKey struct{}
+
+// WithTimeLayout returns a copy of ctx that makes Parse and Decode parse time.Time values with
+// layout instead of time.RFC3339.
+func WithTimeLayout(ctx context.Context, layout string) context.Context {
+	return context.WithValue(ctx, timeLayoutContextKey{}, layout)
+}
+
+// TimeLayoutFromContext returns the time layout stored in ctx or time.RFC3339.
+func TimeLayoutFromContext(ctx context.Context) string {
+	if layout, ok := ctx.Value(timeLayoutContextKey{}).(string); ok {
+		return layout
+	}
+	return time.RFC3339
+}
+
 // ParseTime converts an interface{} value to a time.Time using the specified format.
-// The value is first converted to a string using ParseString, then parsed using time.Parse.
+// time.Time values are returned unchanged, pointers are dereferenced.
+// Other values are first converted to a string using ParseString, then parsed using time.Parse,
+// or like ParseTimeInLocation if WithLocation is set.
+// Unix epochs are only accepted if WithEpochUnit is set: numbers and json.Number are then
+// converted with ParseTimeEpoch, as are numeric strings that do not match format.
 // Format should follow Go's time format layout (e.g., "2006-01-02", "2006-01-02T15:04:05Z07:00").
-// Returns an error if the value cannot be converted to time.Time.
+// Returns a *ParseError if the value cannot be converted to time.Time.
 func ParseTime(ctx context.Context, value interface{}, format string) (time.Time, error) {
-	str, err := ParseString(ctx, value)
+	switch v := indirect(value).(type) {
+	case nil:
+		if err := nilValueError(ctx); err != nil {
+			return time.Time{}, newParseError(value, "time.Time", err)
+		}
+		return time.Time{}, nil
+	case time.Time:
+		return v, nil
+	}
+	unit, epoch := EpochUnitFromContext(ctx)
+	if epoch && isEpochNumber(value) {
+		return ParseTimeEpoch(ctx, value, unit)
+	}
+	str, err := parseString(ctx, value)
 	if err != nil {
-		return time.Time{}, errors.Wrapf(ctx, err, "parse %v as string failed", value)
+		return time.Time{}, newParseError(value, "time.Time", err)
 	}
-	t, err := time.Parse(format, str)
+	t, err := parseTimeLayout(ctx, str, format)
 	if err != nil {
-		return time.Time{}, errors.Wrapf(
-			ctx,
-			err,
-			"parse '%s' with format '%s' failed",
-			value,
-			format,
-		)
+		if epoch && isNumericString(str) {
+			return ParseTimeEpoch(ctx, value, unit)
+		}
+		return time.Time{}, newParseError(value, "time.Time", err)
+	}
+	return t, nil
+}
+
+// parseTimeLayout parses value with layout using time.Parse, or like ParseTimeInLocation if
+// WithLocation is set.
+func parseTimeLayout(ctx context.Context, value string, layout string) (time.Time, error) {
+	if loc, ok := LocationFromContext(ctx); ok {
+		return parseTimeInLocation(ctx, value, layout, loc)
+	}
+	t, err := time.Parse(layout, value)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(ctx, err, "parse with format '%s' failed", layout)
 	}
 	return t, nil
 }
@@ -42,6 +85,9 @@ func ParseTimeDefault(
 	format string,
 	defaultValue time.Time,
 ) time.Time {
+	if isNilDefault(ctx, value) {
+		return defaultValue
+	}
 	result, err := ParseTime(ctx, value, format)
 	if err != nil {
 		return defaultValue
@@ -0,0 +1,38 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse
+
+import (
+	"context"
+)
+
+// ParseUintArray converts an interface{} value to an uint slice.
+// Accepts any slice or array, e.g. []interface{}, []string, []float64 or []MyInt.
+// Each element is converted using ParseUint, see ParseSlice.
+// Returns a *ParseError if the value cannot be converted to []uint.
+func ParseUintArray(ctx context.Context, value interface{}) ([]uint, error) {
+	return ParseSlice[uint](ctx, value)
+}
+
+// ParseUintArrayDefault converts an interface{} value to an uint slice, returning defaultValue on error.
+// This is a convenience wrapper around ParseUintArray that never returns an error.
+func ParseUintArrayDefault(ctx context.Context, value interface{}, defaultValue []uint) []uint {
+	if isNilDefault(ctx, value) {
+		return defaultValue
+	}
+	result, err := ParseUintArray(ctx, value)
+	if err != nil {
+		return defaultValue
+	}
+	return result
+}
+
+// ParseUintArrayFromInterfaces converts a slice of interface{} values to an uint slice.
+// Each element is converted using ParseUint.
+// Returns a *ParseError with the index of the element in Path if any element cannot be
+// converted to uint, or ParseErrors with all failing elements if WithCollectErrors is set.
+func ParseUintArrayFromInterfaces(ctx context.Context, values []interface{}) ([]uint, error) {
+	return ParseSlice[uint](ctx, values)
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/parse"
+)
+
+var _ = DescribeTable("ParseUintArray",
+	func(input interface{}, expectedResult []uint, hasError bool) {
+		result, err := parse.ParseUintArray(context.Background(), input)
+		Expect(result).To(Equal(expectedResult))
+		if hasError {
+			Expect(err).NotTo(BeNil())
+		} else {
+			Expect(err).To(BeNil())
+		}
+	},
+	Entry("[]uint", []uint{1, 2, 3}, []uint{1, 2, 3}, false),
+	Entry("[]interface", []interface{}{1, "2", 3.0}, []uint{1, 2, 3}, false),
+	Entry("[]int64", []int64{1, 2, 3}, []uint{1, 2, 3}, false),
+	Entry("[]float64", []float64{1, 2, 3}, []uint{1, 2, 3}, false),
+	Entry("[]string", []string{"1", "2", "3"}, []uint{1, 2, 3}, false),
+	Entry("negative element", []int{1, -2}, nil, true),
+	Entry("invalid type", "invalid", nil, true),
+)
+
+var _ = DescribeTable("ParseUintArrayDefault",
+	func(input interface{}, defaultValue []uint, expectedResult []uint) {
+		result := parse.ParseUintArrayDefault(context.Background(), input, defaultValue)
+		Expect(result).To(Equal(expectedResult))
+	},
+	Entry("valid []interface{}", []interface{}{1, 2, 3}, []uint{999}, []uint{1, 2, 3}),
+	Entry("invalid returns default", "invalid", []uint{888, 777}, []uint{888, 777}),
+	Entry("nil returns default", nil, []uint{123}, []uint{123}),
+)
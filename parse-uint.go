@@ -0,0 +1,51 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse
+
+import (
+	"context"
+
+	"github.com/bborbe/errors"
+)
+
+// ParseUint converts an interface{} value to an uint.
+// Supported types are the same as for ParseUint64.
+// Returns a *ParseError wrapping an *OverflowError if the value is negative or does not fit
+// into an uint.
+// Returns a *ParseError if the value cannot be converted to uint.
+func ParseUint(ctx context.Context, value interface{}) (uint, error) {
+	result, err := parseUint(ctx, value)
+	if err != nil {
+		return 0, newParseError(value, "uint", err)
+	}
+	return result, nil
+}
+
+func parseUint(ctx context.Context, value interface{}) (uint, error) {
+	result, err := parseUint64(ctx, value)
+	if err != nil {
+		if errors.Is(err, ErrOutOfRange) {
+			return 0, &OverflowError{Value: value, TargetType: "uint"}
+		}
+		return 0, err
+	}
+	if uint64(uint(result)) != result {
+		return 0, &OverflowError{Value: value, TargetType: "uint"}
+	}
+	return uint(result), nil
+}
+
+// ParseUintDefault converts an interface{} value to an uint, returning defaultValue on error.
+// This is a convenience wrapper around ParseUint that never returns an error.
+func ParseUintDefault(ctx context.Context, value interface{}, defaultValue uint) uint {
+	if isNilDefault(ctx, value) {
+		return defaultValue
+	}
+	result, err := ParseUint(ctx, value)
+	if err != nil {
+		return defaultValue
+	}
+	return result
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse
+
+import (
+	"context"
+)
+
+// ParseUint32Array converts an interface{} value to an uint32 slice.
+// Accepts any slice or array, e.g. []interface{}, []string, []float64 or []MyInt.
+// Each element is converted using ParseUint32, see ParseSlice.
+// Returns a *ParseError if the value cannot be converted to []uint32.
+func ParseUint32Array(ctx context.Context, value interface{}) ([]uint32, error) {
+	return ParseSlice[uint32](ctx, value)
+}
+
+// ParseUint32ArrayDefault converts an interface{} value to an uint32 slice, returning defaultValue on error.
+// This is a convenience wrapper around ParseUint32Array that never returns an error.
+func ParseUint32ArrayDefault(
+	ctx context.Context,
+	value interface{},
+	defaultValue []uint32,
+) []uint32 {
+	if isNilDefault(ctx, value) {
+		return defaultValue
+	}
+	result, err := ParseUint32Array(ctx, value)
+	if err != nil {
+		return defaultValue
+	}
+	return result
+}
+
+// ParseUint32ArrayFromInterfaces converts a slice of interface{} values to an uint32 slice.
+// Each element is converted using ParseUint32.
+// Returns a *ParseError with the index of the element in Path if any element cannot be
+// converted to uint32, or ParseErrors with all failing elements if WithCollectErrors is set.
+func ParseUint32ArrayFromInterfaces(ctx context.Context, values []interface{}) ([]uint32, error) {
+	return ParseSlice[uint32](ctx, values)
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse
+
+import (
+	"context"
+	"math"
+
+	"github.com/bborbe/errors"
+)
+
+// ParseUint32 converts an interface{} value to an uint32.
+// Supported types are the same as for ParseUint64.
+// Returns a *ParseError wrapping an *OverflowError if the value is negative or does not fit
+// into an uint32.
+// Returns a *ParseError if the value cannot be converted to uint32.
+func ParseUint32(ctx context.Context, value interface{}) (uint32, error) {
+	result, err := parseUint32(ctx, value)
+	if err != nil {
+		return 0, newParseError(value, "uint32", err)
+	}
+	return result, nil
+}
+
+func parseUint32(ctx context.Context, value interface{}) (uint32, error) {
+	result, err := parseUint64(ctx, value)
+	if err != nil {
+		if errors.Is(err, ErrOutOfRange) {
+			return 0, &OverflowError{Value: value, TargetType: "uint32"}
+		}
+		return 0, err
+	}
+	if result > math.MaxUint32 {
+		return 0, &OverflowError{Value: value, TargetType: "uint32"}
+	}
+	return uint32(result), nil
+}
+
+// ParseUint32Default converts an interface{} value to an uint32, returning defaultValue on error.
+// This is a convenience wrapper around ParseUint32 that never returns an error.
+func ParseUint32Default(ctx context.Context, value interface{}, defaultValue uint32) uint32 {
+	if isNilDefault(ctx, value) {
+		return defaultValue
+	}
+	result, err := ParseUint32(ctx, value)
+	if err != nil {
+		return defaultValue
+	}
+	return result
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse_test
+
+import (
+	"context"
+	"math"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/parse"
+)
+
+var _ = DescribeTable("ParseUint32",
+	func(value interface{}, expectedResult uint32, expectError bool) {
+		result, err := parse.ParseUint32(context.Background(), value)
+		if expectError {
+			Expect(err).NotTo(BeNil())
+			Expect(result).To(Equal(uint32(0)))
+		} else {
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(expectedResult))
+		}
+	},
+	Entry("string", "1337", uint32(1337), false),
+	Entry("max", int64(math.MaxUint32), uint32(math.MaxUint32), false),
+	Entry("float64", 1337.0, uint32(1337), false),
+	Entry("overflow", int64(math.MaxUint32)+1, uint32(0), true),
+	Entry("negative", -1, uint32(0), true),
+	Entry("invalid", "banana", uint32(0), true),
+)
+
+var _ = DescribeTable("ParseUint32Default",
+	func(value interface{}, defaultValue uint32, expectedResult uint32) {
+		result := parse.ParseUint32Default(context.Background(), value, defaultValue)
+		Expect(result).To(Equal(expectedResult))
+	},
+	Entry("valid string", "1337", uint32(999), uint32(1337)),
+	Entry("overflow returns default", uint64(math.MaxUint64), uint32(999), uint32(999)),
+	Entry("nil returns default", nil, uint32(123), uint32(123)),
+)
@@ -0,0 +1,42 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse
+
+import (
+	"context"
+)
+
+// ParseUint64Array converts an interface{} value to an uint64 slice.
+// Accepts any slice or array, e.g. []interface{}, []string, []float64 or []MyInt.
+// Each element is converted using ParseUint64, see ParseSlice.
+// Returns a *ParseError if the value cannot be converted to []uint64.
+func ParseUint64Array(ctx context.Context, value interface{}) ([]uint64, error) {
+	return ParseSlice[uint64](ctx, value)
+}
+
+// ParseUint64ArrayDefault converts an interface{} value to an uint64 slice, returning defaultValue on error.
+// This is a convenience wrapper around ParseUint64Array that never returns an error.
+func ParseUint64ArrayDefault(
+	ctx context.Context,
+	value interface{},
+	defaultValue []uint64,
+) []uint64 {
+	if isNilDefault(ctx, value) {
+		return defaultValue
+	}
+	result, err := ParseUint64Array(ctx, value)
+	if err != nil {
+		return defaultValue
+	}
+	return result
+}
+
+// ParseUint64ArrayFromInterfaces converts a slice of interface{} values to an uint64 slice.
+// Each element is converted using ParseUint64.
+// Returns a *ParseError with the index of the element in Path if any element cannot be
+// converted to uint64, or ParseErrors with all failing elements if WithCollectErrors is set.
+func ParseUint64ArrayFromInterfaces(ctx context.Context, values []interface{}) ([]uint64, error) {
+	return ParseSlice[uint64](ctx, values)
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/bborbe/errors"
+)
+
+// ParseUint64 converts an interface{} value to an uint64.
+// Supported types: uint64, uint32, uint, int64, int32, int, float32, float64, string,
+// json.Number, fmt.Stringer and named types of them.
+// Float values and decimal strings are rounded according to the RoundingMode of ctx,
+// by default to the nearest integer.
+// Integer strings and json.Number are parsed exactly using strconv.ParseUint.
+// Other types are formatted with fmt.Sprintf and parsed, unless strict mode is enabled.
+// Returns a *ParseError wrapping an *OverflowError if the value is
+// negative, out of range for uint64, NaN or infinite.
+// Returns a *ParseError if the value cannot be converted to uint64.
+func ParseUint64(ctx context.Context, value interface{}) (uint64, error) {
+	result, err := parseUint64(ctx, value)
+	if err != nil {
+		return 0, newParseError(value, "uint64", err)
+	}
+	return result, nil
+}
+
+func parseUint64(ctx context.Context, value interface{}) (uint64, error) {
+	value = indirect(value)
+	if value == nil {
+		return 0, nilValueError(ctx)
+	}
+	switch v := value.(type) {
+	case uint64:
+		return v, nil
+	case uint32:
+		return uint64(v), nil
+	case uint:
+		return uint64(v), nil
+	case int64:
+		return int64ToUint64(v, value)
+	case int32:
+		return int64ToUint64(int64(v), value)
+	case int:
+		return int64ToUint64(int64(v), value)
+	case float32:
+		return float64ToUint64(ctx, float64(v), value)
+	case float64:
+		return float64ToUint64(ctx, v, value)
+	case string:
+		return parseUint64String(ctx, v)
+	case json.Number:
+		return parseUint64String(ctx, string(v))
+	case fmt.Stringer:
+		return parseUint64(ctx, v.String())
+	default:
+		if basic, ok := toBasicKind(value); ok {
+			return parseUint64(ctx, basic)
+		}
+		if IsStrict(ctx) {
+			return 0, errUnsupportedType(ctx, value)
+		}
+		return parseUint64(ctx, fmt.Sprintf("%v", value))
+	}
+}
+
+// ParseUint64Default converts an interface{} value to an uint64, returning defaultValue on error.
+// This is a convenience wrapper around ParseUint64 that never returns an error.
+func ParseUint64Default(ctx context.Context, value interface{}, defaultValue uint64) uint64 {
+	if isNilDefault(ctx, value) {
+		return defaultValue
+	}
+	result, err := ParseUint64(ctx, value)
+	if err != nil {
+		return defaultValue
+	}
+	return result
+}
+
+// parseUint64String parses v as unsigned integer, rounding decimal and negative strings exactly
+// and falling back to a float for other strings like "Inf".
+func parseUint64String(ctx context.Context, v string) (uint64, error) {
+	result, err := strconv.ParseUint(v, 10, 64)
+	if err == nil {
+		return result, nil
+	}
+	if errors.Is(err, strconv.ErrRange) {
+		return 0, &OverflowError{Value: v, TargetType: "uint64"}
+	}
+	if i, ok, roundErr := roundDecimalString(ctx, v); ok {
+		if roundErr != nil {
+			return 0, roundErr
+		}
+		if !i.IsUint64() {
+			return 0, &OverflowError{Value: v, TargetType: "uint64"}
+		}
+		return i.Uint64(), nil
+	}
+	f, floatErr := strconv.ParseFloat(v, 64)
+	if floatErr != nil && !errors.Is(floatErr, strconv.ErrRange) {
+		return 0, err
+	}
+	return float64ToUint64(ctx, f, v)
+}
+
+func int64ToUint64(v int64, value interface{}) (uint64, error) {
+	if v < 0 {
+		return 0, &OverflowError{Value: value, TargetType: "uint64"}
+	}
+	return uint64(v), nil
+}
+
+// float64ToUint64 rounds v according to the RoundingMode of ctx and reports an *OverflowError
+// for value if the result is negative or does not fit into an uint64.
+func float64ToUint64(ctx context.Context, v float64, value interface{}) (uint64, error) {
+	rounded, err := roundFloat64(ctx, v)
+	if err != nil {
+		return 0, err
+	}
+	if math.IsNaN(rounded) || rounded < 0 || rounded >= 1<<64 {
+		return 0, &OverflowError{Value: value, TargetType: "uint64"}
+	}
+	return uint64(rounded), nil
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse_test
+
+import (
+	"context"
+	"math"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/parse"
+)
+
+type MyUint64 uint64
+
+var _ = DescribeTable("ParseUint64",
+	func(value interface{}, expectedResult uint64, expectError bool) {
+		result, err := parse.ParseUint64(context.Background(), value)
+		if expectError {
+			Expect(err).NotTo(BeNil())
+			Expect(result).To(Equal(uint64(0)))
+		} else {
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(expectedResult))
+		}
+	},
+	Entry("string", "1337", uint64(1337), false),
+	Entry("max string", "18446744073709551615", uint64(math.MaxUint64), false),
+	Entry("stringer", MyStringer("1337"), uint64(1337), false),
+	Entry("custom uint64", MyUint64(1337), uint64(1337), false),
+	Entry("uint64", uint64(math.MaxUint64), uint64(math.MaxUint64), false),
+	Entry("uint32", uint32(1337), uint64(1337), false),
+	Entry("uint", uint(1337), uint64(1337), false),
+	Entry("int", 1337, uint64(1337), false),
+	Entry("int32", int32(1337), uint64(1337), false),
+	Entry("int64", int64(1337), uint64(1337), false),
+	Entry("float32", float32(1337), uint64(1337), false),
+	Entry("float64", 1337.4, uint64(1337), false),
+	Entry("negative int", -1, uint64(0), true),
+	Entry("negative float64", -1.0, uint64(0), true),
+	Entry("negative string", "-1", uint64(0), true),
+	Entry("decimal string", "1336.6", uint64(1337), false),
+	Entry(
+		"max decimal string",
+		"18446744073709551615.0",
+		uint64(math.MaxUint64),
+		false,
+	),
+	Entry("negative decimal string", "-1.5", uint64(0), true),
+	Entry("negative decimal string", "-1.5", uint64(0), true),
+	Entry("overflow string", "18446744073709551616", uint64(0), true),
+	Entry("overflow float64", 1e20, uint64(0), true),
+	Entry("NaN", math.NaN(), uint64(0), true),
+	Entry("invalid", "banana", uint64(0), true),
+)
+
+var _ = DescribeTable("ParseUint64Default",
+	func(value interface{}, defaultValue uint64, expectedResult uint64) {
+		result := parse.ParseUint64Default(context.Background(), value, defaultValue)
+		Expect(result).To(Equal(expectedResult))
+	},
+	Entry("valid string", "1337", uint64(999), uint64(1337)),
+	Entry("valid float64", 42.0, uint64(999), uint64(42)),
+	Entry("negative returns default", -5, uint64(999), uint64(999)),
+	Entry("invalid returns default", "banana", uint64(999), uint64(999)),
+	Entry("nil returns default", nil, uint64(123), uint64(123)),
+)
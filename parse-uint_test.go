@@ -0,0 +1,42 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/parse"
+)
+
+var _ = DescribeTable("ParseUint",
+	func(value interface{}, expectedResult uint, expectError bool) {
+		result, err := parse.ParseUint(context.Background(), value)
+		if expectError {
+			Expect(err).NotTo(BeNil())
+			Expect(result).To(Equal(uint(0)))
+		} else {
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(expectedResult))
+		}
+	},
+	Entry("string", "1337", uint(1337), false),
+	Entry("int", 1337, uint(1337), false),
+	Entry("float64", 1337.0, uint(1337), false),
+	Entry("negative", -1, uint(0), true),
+	Entry("invalid", "banana", uint(0), true),
+)
+
+var _ = DescribeTable("ParseUintDefault",
+	func(value interface{}, defaultValue uint, expectedResult uint) {
+		result := parse.ParseUintDefault(context.Background(), value, defaultValue)
+		Expect(result).To(Equal(expectedResult))
+	},
+	Entry("valid string", "1337", uint(999), uint(1337)),
+	Entry("invalid returns default", "banana", uint(999), uint(999)),
+	Entry("nil returns default", nil, uint(123), uint(123)),
+)
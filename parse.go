@@ -0,0 +1,324 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse
+
+import (
+	"context"
+	"encoding"
+	"reflect"
+	"time"
+
+	"github.com/bborbe/errors"
+)
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
+)
+
+// Parse converts an interface{} value to T by dispatching to the matching typed parser.
+// Supported targets: all integer and float types, bool, string, time.Time
+// (parsed with the layout of WithTimeLayout, by default time.RFC3339), time.Duration
+// (parsed with ParseDuration), slices, maps, pointers,
+// interfaces and Optional values of supported targets, structs (decoded from maps like in
+// Decode) and any type whose pointer implements encoding.TextUnmarshaler (e.g. net.IP,
+// netip.Addr or *big.Int).
+// Named types such as `type Port int` or `type Direction string` are handled through their
+// underlying kind.
+// Returns a *ParseError matching ErrInvalidType if T has no matching parser,
+// or ParseErrors with all failing elements and fields if WithCollectErrors is set.
+func Parse[T any](ctx context.Context, value interface{}) (T, error) {
+	var result T
+	parsed, err := parseValue(ctx, value, reflect.TypeOf(&result).Elem())
+	if err != nil {
+		return result, err
+	}
+	reflect.ValueOf(&result).Elem().Set(parsed)
+	return result, nil
+}
+
+// ParseDefault converts an interface{} value to T, returning defaultValue on error.
+// This is a convenience wrapper around Parse that never returns an error.
+func ParseDefault[T any](ctx context.Context, value interface{}, defaultValue T) T {
+	if isNilDefault(ctx, value) {
+		return defaultValue
+	}
+	result, err := Parse[T](ctx, value)
+	if err != nil {
+		return defaultValue
+	}
+	return result
+}
+
+// parseValue converts value into a reflect.Value of targetType using the typed parsers.
+func parseValue(
+	ctx context.Context,
+	value interface{},
+	targetType reflect.Type,
+) (reflect.Value, error) {
+	if targetType == timeType {
+		result, err := ParseTime(ctx, value, TimeLayoutFromContext(ctx))
+		return convertResult(result, err, targetType)
+	}
+	if targetType == durationType {
+		result, err := ParseDuration(ctx, value)
+		return convertResult(result, err, targetType)
+	}
+	if reflect.PointerTo(targetType).Implements(optionalTargetType) {
+		result := reflect.New(targetType)
+		target, ok := result.Interface().(optionalTarget)
+		if !ok {
+			return reflect.Value{}, errInvalidTargetType(ctx, value, targetType)
+		}
+		if err := target.parseOptional(ctx, value); err != nil {
+			return reflect.Value{}, err
+		}
+		return result.Elem(), nil
+	}
+	if reflect.PointerTo(targetType).Implements(textUnmarshalerType) {
+		return parseTextValue(ctx, value, targetType)
+	}
+	switch targetType.Kind() {
+	case reflect.Int:
+		result, err := ParseInt(ctx, value)
+		return convertResult(result, err, targetType)
+	case reflect.Int64:
+		result, err := ParseInt64(ctx, value)
+		return convertResult(result, err, targetType)
+	case reflect.Uint:
+		result, err := ParseUint(ctx, value)
+		return convertResult(result, err, targetType)
+	case reflect.Uint32:
+		result, err := ParseUint32(ctx, value)
+		return convertResult(result, err, targetType)
+	case reflect.Uint64:
+		result, err := ParseUint64(ctx, value)
+		return convertResult(result, err, targetType)
+	case reflect.Float64:
+		result, err := ParseFloat64(ctx, value)
+		return convertResult(result, err, targetType)
+	case reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Uint8, reflect.Uint16, reflect.Float32:
+		return parseSizedValue(ctx, value, targetType)
+	case reflect.Bool:
+		result, err := ParseBool(ctx, value)
+		return convertResult(result, err, targetType)
+	case reflect.String:
+		result, err := ParseString(ctx, value)
+		return convertResult(result, err, targetType)
+	case reflect.Slice:
+		return parseSliceValue(ctx, value, targetType)
+	case reflect.Pointer:
+		return parsePointerValue(ctx, value, targetType)
+	case reflect.Struct:
+		return parseStructValue(ctx, value, targetType)
+	case reflect.Map:
+		return parseMapValue(ctx, value, targetType)
+	case reflect.Interface:
+		return parseInterfaceValue(ctx, value, targetType)
+	default:
+		return reflect.Value{}, errInvalidTargetType(ctx, value, targetType)
+	}
+}
+
+// parseSizedValue converts value into the sized integer or float targetType using ParseInt64,
+// ParseUint64 or ParseFloat64 and reports an *OverflowError if the result does not fit.
+func parseSizedValue(
+	ctx context.Context,
+	value interface{},
+	targetType reflect.Type,
+) (reflect.Value, error) {
+	result := reflect.New(targetType).Elem()
+	var overflow bool
+	switch targetType.Kind() {
+	case reflect.Uint8, reflect.Uint16:
+		parsed, err := ParseUint64(ctx, value)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		overflow = result.OverflowUint(parsed)
+		result.SetUint(parsed)
+	case reflect.Float32:
+		parsed, err := ParseFloat64(ctx, value)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		overflow = result.OverflowFloat(parsed)
+		result.SetFloat(parsed)
+	default:
+		parsed, err := ParseInt64(ctx, value)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		overflow = result.OverflowInt(parsed)
+		result.SetInt(parsed)
+	}
+	if overflow {
+		return reflect.Value{}, newParseError(
+			value,
+			targetType.String(),
+			&OverflowError{Value: value, TargetType: targetType.String()},
+		)
+	}
+	return result, nil
+}
+
+// parseTextValue converts value into targetType using its encoding.TextUnmarshaler.
+// Values that already have targetType are returned unchanged.
+func parseTextValue(
+	ctx context.Context,
+	value interface{},
+	targetType reflect.Type,
+) (reflect.Value, error) {
+	if value != nil && reflect.TypeOf(value) == targetType {
+		return reflect.ValueOf(value), nil
+	}
+	result := reflect.New(targetType)
+	target, ok := result.Interface().(encoding.TextUnmarshaler)
+	if !ok {
+		return reflect.Value{}, errInvalidTargetType(ctx, value, targetType)
+	}
+	if err := ParseTextUnmarshaler(ctx, value, target); err != nil {
+		return reflect.Value{}, err
+	}
+	return result.Elem(), nil
+}
+
+// parsePointerValue converts value into a pointer of targetType.
+// A nil value results in a nil pointer.
+func parsePointerValue(
+	ctx context.Context,
+	value interface{},
+	targetType reflect.Type,
+) (reflect.Value, error) {
+	if indirect(value) == nil {
+		return reflect.Zero(targetType), nil
+	}
+	elem, err := parseValue(ctx, value, targetType.Elem())
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	result := reflect.New(targetType.Elem())
+	result.Elem().Set(elem)
+	return result, nil
+}
+
+// parseSliceValue converts value into a slice of targetType.
+// String slices use ParseStrings, all other slices are converted element by element.
+func parseSliceValue(
+	ctx context.Context,
+	value interface{},
+	targetType reflect.Type,
+) (reflect.Value, error) {
+	if targetType.Elem().Kind() == reflect.String &&
+		!reflect.PointerTo(targetType.Elem()).Implements(textUnmarshalerType) {
+		result, err := ParseStrings(ctx, value)
+		return convertResult(result, err, targetType)
+	}
+	return parseSliceElements(ctx, value, targetType)
+}
+
+// parseSliceElements converts a slice or array value into a slice of targetType by converting
+// each element with parseValue.
+func parseSliceElements(
+	ctx context.Context,
+	value interface{},
+	targetType reflect.Type,
+) (reflect.Value, error) {
+	v := indirect(value)
+	if v == nil {
+		if err := nilValueError(ctx); err != nil {
+			return reflect.Value{}, newParseError(value, targetType.String(), err)
+		}
+		return reflect.Zero(targetType), nil
+	}
+	if reflect.TypeOf(v) == targetType {
+		return reflect.ValueOf(v), nil
+	}
+	v, err := expandString(ctx, v)
+	if err != nil {
+		return reflect.Value{}, newParseError(value, targetType.String(), err)
+	}
+	source := reflect.ValueOf(v)
+	if source.Kind() != reflect.Slice && source.Kind() != reflect.Array {
+		return reflect.Value{}, newParseError(
+			value,
+			targetType.String(),
+			errUnsupportedType(ctx, v),
+		)
+	}
+	result := reflect.MakeSlice(targetType, source.Len(), source.Len())
+	errs := newErrorCollector(ctx)
+	for i := 0; i < source.Len(); i++ {
+		elem, err := parseValue(ctx, source.Index(i).Interface(), targetType.Elem())
+		if err != nil {
+			if !errs.add(err, indexPath(i)) {
+				break
+			}
+			continue
+		}
+		result.Index(i).Set(elem)
+	}
+	if err := errs.err(); err != nil {
+		return reflect.Value{}, err
+	}
+	return result, nil
+}
+
+// parseInterfaceValue stores value in an interface of targetType if value implements it.
+func parseInterfaceValue(
+	ctx context.Context,
+	value interface{},
+	targetType reflect.Type,
+) (reflect.Value, error) {
+	result := reflect.New(targetType).Elem()
+	if value == nil {
+		return result, nil
+	}
+	if !reflect.TypeOf(value).Implements(targetType) {
+		return reflect.Value{}, errInvalidTargetType(ctx, value, targetType)
+	}
+	result.Set(reflect.ValueOf(value))
+	return result, nil
+}
+
+// convertResult converts the result of a typed parser to targetType.
+func convertResult[T any](result T, err error, targetType reflect.Type) (reflect.Value, error) {
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	return convertValue(reflect.ValueOf(result), targetType), nil
+}
+
+// convertValue converts value to targetType.
+// Slices are converted element by element so []string can become []Direction.
+func convertValue(value reflect.Value, targetType reflect.Type) reflect.Value {
+	if value.Type() == targetType {
+		return value
+	}
+	if value.Kind() != reflect.Slice || value.Type().ConvertibleTo(targetType) {
+		return value.Convert(targetType)
+	}
+	if value.IsNil() {
+		return reflect.Zero(targetType)
+	}
+	result := reflect.MakeSlice(targetType, value.Len(), value.Len())
+	for i := 0; i < value.Len(); i++ {
+		result.Index(i).Set(value.Index(i).Convert(targetType.Elem()))
+	}
+	return result
+}
+
+func errInvalidTargetType(
+	ctx context.Context,
+	value interface{},
+	targetType reflect.Type,
+) error {
+	return newParseError(
+		value,
+		targetType.String(),
+		errors.Wrapf(ctx, ErrInvalidType, "no parser for target type %v", targetType),
+	)
+}
@@ -15,17 +15,18 @@ import (
 )
 
 // ParseASCII returns a the given string converted to ascii
+// Returns a *ParseError if the value cannot be converted.
 func ParseASCII(ctx context.Context, value interface{}) (string, error) {
-	str, err := ParseString(ctx, value)
+	str, err := parseString(ctx, value)
 	if err != nil {
-		return "", errors.Wrapf(ctx, err, "convert to ascii failed")
+		return "", newParseError(value, "ascii", err)
 	}
 	result, _, err := transform.String(
 		transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn))),
 		str,
 	)
 	if err != nil {
-		return "", err
+		return "", newParseError(value, "ascii", errors.Wrapf(ctx, err, "convert to ascii failed"))
 	}
 	return result, nil
 }
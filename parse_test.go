@@ -0,0 +1,144 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package parse_test
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/parse"
+)
+
+type Port int
+
+type SmallPort uint16
+
+var _ = Describe("Parse", func() {
+	var ctx context.Context
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+	It("parses int", func() {
+		result, err := parse.Parse[int](ctx, "1337")
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal(1337))
+	})
+	It("parses int64", func() {
+		result, err := parse.Parse[int64](ctx, 42.0)
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal(int64(42)))
+	})
+	It("parses float64", func() {
+		result, err := parse.Parse[float64](ctx, "1.5")
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal(1.5))
+	})
+	It("parses bool", func() {
+		result, err := parse.Parse[bool](ctx, "TRUE")
+		Expect(err).To(BeNil())
+		Expect(result).To(BeTrue())
+	})
+	It("parses string", func() {
+		result, err := parse.Parse[string](ctx, 42)
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal("42"))
+	})
+	It("parses named int", func() {
+		result, err := parse.Parse[Port](ctx, "8080")
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal(Port(8080)))
+	})
+	It("parses uint64", func() {
+		result, err := parse.Parse[uint64](ctx, "18446744073709551615")
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal(uint64(18446744073709551615)))
+	})
+	It("parses []uint32", func() {
+		result, err := parse.Parse[[]uint32](ctx, []interface{}{"1", 2.0})
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal([]uint32{1, 2}))
+	})
+	It("parses named string", func() {
+		result, err := parse.Parse[Direction](ctx, "up")
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal(Direction("up")))
+	})
+	It("parses []string", func() {
+		result, err := parse.Parse[[]string](ctx, []interface{}{"a", 1})
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal([]string{"a", "1"}))
+	})
+	It("parses slice of named string", func() {
+		result, err := parse.Parse[[]Direction](ctx, []string{"up", "down"})
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal([]Direction{"up", "down"}))
+	})
+	It("parses []int", func() {
+		result, err := parse.Parse[[]int](ctx, []float64{1, 2})
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal([]int{1, 2}))
+	})
+	It("parses []int64", func() {
+		result, err := parse.Parse[[]int64](ctx, []interface{}{"1", 2})
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal([]int64{1, 2}))
+	})
+	It("parses time.Time as RFC3339", func() {
+		result, err := parse.Parse[time.Time](ctx, "2023-12-25T10:30:00Z")
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal(time.Date(2023, 12, 25, 10, 30, 0, 0, time.UTC)))
+	})
+	It("parses sized integer and float kinds", func() {
+		Expect(parse.Parse[int8](ctx, "-8")).To(Equal(int8(-8)))
+		Expect(parse.Parse[int16](ctx, 16.0)).To(Equal(int16(16)))
+		Expect(parse.Parse[int32](ctx, json.Number("32"))).To(Equal(int32(32)))
+		Expect(parse.Parse[uint8](ctx, "8")).To(Equal(uint8(8)))
+		Expect(parse.Parse[uint16](ctx, 16)).To(Equal(uint16(16)))
+		Expect(parse.Parse[float32](ctx, "1.5")).To(Equal(float32(1.5)))
+	})
+	It("parses named sized kind", func() {
+		result, err := parse.Parse[SmallPort](ctx, "8080")
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal(SmallPort(8080)))
+	})
+	DescribeTable("returns ErrOutOfRange for sized kinds",
+		func(parseFn func() error) {
+			err := parseFn()
+			Expect(stderrors.Is(err, parse.ErrOutOfRange)).To(BeTrue())
+			var overflowErr *parse.OverflowError
+			Expect(stderrors.As(err, &overflowErr)).To(BeTrue())
+		},
+		Entry("int8", func() error { _, err := parse.Parse[int8](ctx, 128); return err }),
+		Entry("int32", func() error { _, err := parse.Parse[int32](ctx, "3000000000"); return err }),
+		Entry("uint8", func() error { _, err := parse.Parse[uint8](ctx, 256); return err }),
+		Entry("named uint16", func() error { _, err := parse.Parse[SmallPort](ctx, 70000); return err }),
+		Entry("float32", func() error { _, err := parse.Parse[float32](ctx, 1e300); return err }),
+	)
+	It("returns error for invalid value", func() {
+		result, err := parse.Parse[int](ctx, "banana")
+		Expect(err).NotTo(BeNil())
+		Expect(result).To(Equal(0))
+	})
+	It("returns ErrInvalidType for unsupported target", func() {
+		_, err := parse.Parse[complex128](ctx, 1)
+		Expect(err).To(MatchError(parse.ErrInvalidType))
+	})
+})
+
+var _ = DescribeTable("ParseDefault",
+	func(value interface{}, defaultValue Port, expectedResult Port) {
+		result := parse.ParseDefault(context.Background(), value, defaultValue)
+		Expect(result).To(Equal(expectedResult))
+	},
+	Entry("valid string", "80", Port(999), Port(80)),
+	Entry("valid int", 443, Port(999), Port(443)),
+	Entry("invalid returns default", "banana", Port(999), Port(999)),
+	Entry("nil returns default", nil, Port(123), Port(123)),
+)